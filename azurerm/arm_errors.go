@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// armCorrelationIDHeader contains the ID which Azure Support use to trace a request through ARM
+	armCorrelationIDHeader = "x-ms-correlation-request-id"
+
+	// armRequestIDHeader contains the ID of the request within the Resource Provider
+	armRequestIDHeader = "x-ms-request-id"
+)
+
+// retryableArmErrorCodes are the ARM error codes which indicate a request can be retried
+// regardless of the Status Code returned, for example when the resource is busy.
+var retryableArmErrorCodes = map[string]struct{}{
+	"anotheroperationinprogress": {},
+	"internalservererror":        {},
+	"operationpreempted":         {},
+	"retryableerror":             {},
+	"servertimeout":              {},
+	"serviceunavailable":         {},
+	"toomanyrequests":            {},
+}
+
+// armErrorDetail represents an error within the ARM error envelope, which can contain
+// further (nested) details about what caused the error.
+type armErrorDetail struct {
+	Code    string           `json:"code"`
+	Message string           `json:"message"`
+	Target  string           `json:"target"`
+	Details []armErrorDetail `json:"details"`
+}
+
+// armError is an error returned from Azure Resource Manager, parsed from the error envelope
+// (`{"error": { "code": "", "message": "", "target": "", "details": [] }}`) along with the
+// IDs needed to trace the request.
+type armError struct {
+	armErrorDetail
+
+	StatusCode    int
+	CorrelationID string
+	RequestID     string
+}
+
+// Error returns a multi-line description of the error, including the Correlation ID which
+// should be included in any support requests raised with Azure.
+func (e armError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Azure Resource Manager returned an error (Status Code %d):\n", e.StatusCode)
+	writeArmErrorDetail(&buf, e.armErrorDetail, "  ")
+
+	if e.CorrelationID != "" {
+		fmt.Fprintf(&buf, "  Correlation ID: %s\n", e.CorrelationID)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&buf, "  Request ID: %s\n", e.RequestID)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func writeArmErrorDetail(buf *bytes.Buffer, detail armErrorDetail, indent string) {
+	if detail.Code != "" {
+		fmt.Fprintf(buf, "%sCode: %q\n", indent, detail.Code)
+	}
+	if detail.Message != "" {
+		fmt.Fprintf(buf, "%sMessage: %q\n", indent, detail.Message)
+	}
+	if detail.Target != "" {
+		fmt.Fprintf(buf, "%sTarget: %q\n", indent, detail.Target)
+	}
+
+	if len(detail.Details) > 0 {
+		fmt.Fprintf(buf, "%sDetails:\n", indent)
+		for _, nested := range detail.Details {
+			// the first line of each nested detail is prefixed with a bullet, and subsequent lines aligned with it
+			var nestedBuf bytes.Buffer
+			writeArmErrorDetail(&nestedBuf, nested, "")
+			lines := strings.Split(strings.TrimSuffix(nestedBuf.String(), "\n"), "\n")
+			for i, line := range lines {
+				prefix := "  "
+				if i == 0 {
+					prefix = "- "
+				}
+				fmt.Fprintf(buf, "%s  %s%s\n", indent, prefix, line)
+			}
+		}
+	}
+}
+
+// retryable returns whether the request which caused this error can be retried.
+func (e armError) retryable() bool {
+	if _, ok := retryableStatusCodes[e.StatusCode]; ok {
+		return true
+	}
+
+	_, ok := retryableArmErrorCodes[strings.ToLower(e.Code)]
+	return ok
+}
+
+// parseArmError parses the ARM error envelope from the body of an unsuccessful response,
+// which is left intact so it can be read again by the SDK.
+func parseArmError(resp *http.Response) *armError {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	output := armError{
+		StatusCode:    resp.StatusCode,
+		CorrelationID: resp.Header.Get(armCorrelationIDHeader),
+		RequestID:     resp.Header.Get(armRequestIDHeader),
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		body, _ = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	// most Resource Providers wrap the error in an `error` object, however some return it directly
+	var envelope struct {
+		Error *armErrorDetail `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Error != nil {
+		output.armErrorDetail = *envelope.Error
+	} else if err := json.Unmarshal(body, &output.armErrorDetail); err != nil || output.Code == "" {
+		output.armErrorDetail = armErrorDetail{
+			Message: strings.TrimSpace(string(body)),
+		}
+	}
+
+	if output.Message == "" {
+		output.Message = http.StatusText(resp.StatusCode)
+	}
+
+	return &output
+}
+
+// withErrorTranslation returns a SendDecorator which returns an armError for unsuccessful
+// responses from ARM, such that the error surfaced by every resource includes the details
+// of the error & the Correlation ID. The response is returned unchanged alongside the error,
+// so that callers can continue to check the Status Code (e.g. for a 404).
+//
+// HEAD requests are excluded since a 404 is a valid response to an existence check.
+func withErrorTranslation() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || r.Method == http.MethodHead {
+				return resp, err
+			}
+
+			if e := parseArmError(resp); e != nil {
+				return resp, *e
+			}
+
+			return resp, nil
+		})
+	}
+}
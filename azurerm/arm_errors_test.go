@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testArmErrorResponse(statusCode int, body string) *http.Response {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+	resp.Header.Set(armCorrelationIDHeader, "11111111-1111-1111-1111-111111111111")
+	resp.Header.Set(armRequestIDHeader, "22222222-2222-2222-2222-222222222222")
+	return resp
+}
+
+func TestParseArmError(t *testing.T) {
+	resp := testArmErrorResponse(http.StatusBadRequest, `{
+  "error": {
+    "code": "InvalidTemplateDeployment",
+    "message": "The template deployment failed.",
+    "target": "properties.template",
+    "details": [
+      {
+        "code": "InvalidParameter",
+        "message": "The value of parameter imageReference.sku is invalid.",
+        "target": "imageReference.sku",
+        "details": [
+          { "code": "SkuNotAvailable", "message": "The SKU isn't available in this region." }
+        ]
+      }
+    ]
+  }
+}`)
+
+	armErr := parseArmError(resp)
+	if armErr == nil {
+		t.Fatalf("Expected an error to be parsed from the response")
+	}
+
+	if armErr.Code != "InvalidTemplateDeployment" || armErr.Target != "properties.template" {
+		t.Fatalf("Expected the Code and Target to be parsed but got %q / %q", armErr.Code, armErr.Target)
+	}
+	if len(armErr.Details) != 1 || len(armErr.Details[0].Details) != 1 {
+		t.Fatalf("Expected the nested Details to be parsed but got %+v", armErr.Details)
+	}
+	if armErr.CorrelationID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected the Correlation ID to be parsed but got %q", armErr.CorrelationID)
+	}
+
+	expected := `Azure Resource Manager returned an error (Status Code 400):
+  Code: "InvalidTemplateDeployment"
+  Message: "The template deployment failed."
+  Target: "properties.template"
+  Details:
+    - Code: "InvalidParameter"
+      Message: "The value of parameter imageReference.sku is invalid."
+      Target: "imageReference.sku"
+      Details:
+        - Code: "SkuNotAvailable"
+          Message: "The SKU isn't available in this region."
+  Correlation ID: 11111111-1111-1111-1111-111111111111
+  Request ID: 22222222-2222-2222-2222-222222222222`
+	if actual := armErr.Error(); actual != expected {
+		t.Fatalf("Expected the error to be:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+
+	// the body should be available to the SDK
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "InvalidTemplateDeployment") {
+		t.Fatalf("Expected the response body to be left intact but got %q", string(body))
+	}
+}
+
+func TestParseArmError_Formats(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		body       string
+		code       string
+		message    string
+	}{
+		{http.StatusConflict, `{"code":"Conflict","message":"The resource is being deleted."}`, "Conflict", "The resource is being deleted."},
+		{http.StatusBadGateway, `<html>Bad Gateway</html>`, "", "<html>Bad Gateway</html>"},
+		{http.StatusNotFound, ``, "", "Not Found"},
+	}
+
+	for _, tc := range testCases {
+		armErr := parseArmError(testArmErrorResponse(tc.statusCode, tc.body))
+		if armErr == nil {
+			t.Fatalf("Expected an error to be parsed for %q", tc.body)
+		}
+		if armErr.Code != tc.code || armErr.Message != tc.message {
+			t.Fatalf("Expected %q to be parsed as %q / %q but got %q / %q", tc.body, tc.code, tc.message, armErr.Code, armErr.Message)
+		}
+	}
+
+	if parseArmError(testArmErrorResponse(http.StatusOK, `{}`)) != nil {
+		t.Fatalf("Expected no error to be parsed for a successful response")
+	}
+}
+
+func TestArmError_Retryable(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		code       string
+		retryable  bool
+	}{
+		{http.StatusTooManyRequests, "", true},
+		{http.StatusServiceUnavailable, "", true},
+		{http.StatusConflict, "AnotherOperationInProgress", true},
+		{http.StatusConflict, "Conflict", false},
+		{http.StatusBadRequest, "InvalidParameter", false},
+		{http.StatusNotFound, "ResourceNotFound", false},
+	}
+
+	for _, tc := range testCases {
+		armErr := armError{
+			armErrorDetail: armErrorDetail{Code: tc.code},
+			StatusCode:     tc.statusCode,
+		}
+		if armErr.retryable() != tc.retryable {
+			t.Fatalf("Expected a %d with the code %q to be retryable: %t", tc.statusCode, tc.code, tc.retryable)
+		}
+	}
+}
+
+func TestWithErrorTranslation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(armCorrelationIDHeader, "11111111-1111-1111-1111-111111111111")
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"code":"ResourceGroupNotFound","message":"Resource group 'example' could not be found."}}`)
+	}))
+	defer server.Close()
+
+	client := resources.NewGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.Sender = autorest.CreateSender(withErrorTranslation())
+
+	resp, err := client.Get("example")
+	if err == nil {
+		t.Fatalf("Expected an error to be returned")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the response to be returned alongside the error but got %d", resp.StatusCode)
+	}
+	for _, expected := range []string{`Code: "ResourceGroupNotFound"`, "Correlation ID: 11111111-1111-1111-1111-111111111111"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("Expected the error to contain %q but got: %s", expected, err)
+		}
+	}
+
+	// a 404 is a valid response to an existence check
+	existence, err := client.CheckExistence("example")
+	if err != nil {
+		t.Fatalf("Expected no error for an existence check but got: %+v", err)
+	}
+	if existence.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 but got %d", existence.StatusCode)
+	}
+}
@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/mitchellh/go-homedir"
+)
+
+// azureCLIProfile represents the subset of the Azure CLI's `azureProfile.json`
+// which we need to determine the default Subscription & Tenant.
+type azureCLIProfile struct {
+	Subscriptions []azureCLISubscription `json:"subscriptions"`
+}
+
+type azureCLISubscription struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	TenantID        string `json:"tenantId"`
+	IsDefault       bool   `json:"isDefault"`
+	EnvironmentName string `json:"environmentName"`
+}
+
+// azureCLIAccessToken represents a single entry in the Azure CLI's `accessTokens.json`.
+type azureCLIAccessToken struct {
+	AccessToken  string `json:"accessToken"`
+	Authority    string `json:"_authority"`
+	ClientID     string `json:"_clientId"`
+	ExpiresOn    string `json:"expiresOn"`
+	RefreshToken string `json:"refreshToken"`
+	Resource     string `json:"resource"`
+	TokenType    string `json:"tokenType"`
+	UserID       string `json:"userId"`
+}
+
+// the Azure CLI writes the expiry time without a timezone, in the local time of the machine
+const azureCLIExpiresOnFormat = "2006-01-02 15:04:05.999999"
+
+func (t azureCLIAccessToken) expiresOn() (time.Time, error) {
+	return time.ParseInLocation(azureCLIExpiresOnFormat, t.ExpiresOn, time.Local)
+}
+
+// toADALToken converts the Azure CLI's representation of a token into an adal.Token
+// which can be used (and refreshed) by the Service Principal Token.
+func (t azureCLIAccessToken) toADALToken() (*adal.Token, error) {
+	expiresOn, err := t.expiresOn()
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the expiry time %q of the Azure CLI token: %+v", t.ExpiresOn, err)
+	}
+
+	return &adal.Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresOn:    strconv.FormatInt(expiresOn.Unix(), 10),
+		Resource:     t.Resource,
+		Type:         t.TokenType,
+	}, nil
+}
+
+// azureCLIConfigDir returns the directory the Azure CLI stores its configuration in,
+// which can be overridden using the `AZURE_CONFIG_DIR` environment variable.
+func azureCLIConfigDir() (string, error) {
+	if dir := os.Getenv("AZURE_CONFIG_DIR"); dir != "" {
+		return dir, nil
+	}
+
+	return homedir.Expand("~/.azure")
+}
+
+func readAzureCLIFile(path string, v interface{}) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// the Azure CLI writes some of these files with a UTF-8 Byte Order Mark
+	contents = bytes.TrimPrefix(contents, []byte("\xef\xbb\xbf"))
+
+	return json.Unmarshal(contents, v)
+}
+
+// loadTokensFromAzureCLI populates the Subscription ID, Tenant ID, Client ID and the
+// Access Token from the credentials cached by the Azure CLI (via `az login`).
+// Any Subscription or Tenant ID set in the Provider block takes precedence.
+func (c *Config) loadTokensFromAzureCLI() error {
+	configDir, err := azureCLIConfigDir()
+	if err != nil {
+		return fmt.Errorf("Error determining the Azure CLI configuration directory: %+v", err)
+	}
+
+	var profile azureCLIProfile
+	profilePath := filepath.Join(configDir, "azureProfile.json")
+	if err := readAzureCLIFile(profilePath, &profile); err != nil {
+		return fmt.Errorf("Error loading the Azure CLI Profile from %q: %+v", profilePath, err)
+	}
+
+	for _, subscription := range profile.Subscriptions {
+		if c.SubscriptionID == "" && subscription.IsDefault {
+			c.SubscriptionID = subscription.ID
+		}
+
+		if c.TenantID == "" && strings.EqualFold(subscription.ID, c.SubscriptionID) {
+			c.TenantID = subscription.TenantID
+		}
+	}
+
+	if c.SubscriptionID == "" {
+		return fmt.Errorf("No Subscription ID was specified and no default Subscription was found in the Azure CLI Profile")
+	}
+	if c.TenantID == "" {
+		return fmt.Errorf("Subscription %q was not found in the Azure CLI Profile", c.SubscriptionID)
+	}
+
+	var tokens []azureCLIAccessToken
+	tokensPath := filepath.Join(configDir, "accessTokens.json")
+	if err := readAzureCLIFile(tokensPath, &tokens); err != nil {
+		return fmt.Errorf("Error loading the Azure CLI Access Tokens from %q: %+v", tokensPath, err)
+	}
+
+	env, err := c.azureEnvironment()
+	if err != nil {
+		return err
+	}
+
+	var selected *azureCLIAccessToken
+	var selectedExpiresOn time.Time
+	for i, token := range tokens {
+		if !strings.HasSuffix(strings.TrimSuffix(token.Authority, "/"), c.TenantID) {
+			continue
+		}
+
+		if !azureCLITokenResourceMatches(token.Resource, env.ResourceManagerEndpoint, env.ServiceManagementEndpoint) {
+			continue
+		}
+
+		// prefer the most recently refreshed token for this tenant
+		expiresOn, err := token.expiresOn()
+		if err != nil {
+			log.Printf("[DEBUG] Ignoring Azure CLI token with an unparsable expiry time %q", token.ExpiresOn)
+			continue
+		}
+
+		if selected == nil || expiresOn.After(selectedExpiresOn) {
+			selected = &tokens[i]
+			selectedExpiresOn = expiresOn
+		}
+	}
+
+	if selected == nil {
+		return fmt.Errorf("No Azure CLI Access Token was found for Tenant %q - please log in using `az login`", c.TenantID)
+	}
+
+	if selected.RefreshToken == "" && selectedExpiresOn.Before(time.Now()) {
+		return fmt.Errorf("The Azure CLI Access Token for Tenant %q has expired and can't be refreshed - please log in again using `az login`", c.TenantID)
+	}
+
+	token, err := selected.toADALToken()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Using the Azure CLI Access Token for %q (Tenant %q)", selected.UserID, c.TenantID)
+	c.ClientID = selected.ClientID
+	c.AccessToken = token
+	return nil
+}
+
+func azureCLITokenResourceMatches(resource string, endpoints ...string) bool {
+	normalized := strings.TrimSuffix(strings.ToLower(resource), "/")
+	for _, endpoint := range endpoints {
+		if normalized == strings.TrimSuffix(strings.ToLower(endpoint), "/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newServicePrincipalTokenFromAzureCLI returns a Service Principal Token for the specified
+// resource which is seeded from the Azure CLI's Access Token. Where the token was issued for
+// a different resource (e.g. the Graph API) the Access Token is discarded, so that a new one
+// is obtained via the Refresh Token the first time it's used.
+func newServicePrincipalTokenFromAzureCLI(oauthConfig adal.OAuthConfig, clientID string, resource string, token adal.Token, equivalentResources ...string) (*adal.ServicePrincipalToken, error) {
+	if !azureCLITokenResourceMatches(token.Resource, append(equivalentResources, resource)...) {
+		token.AccessToken = ""
+		token.ExpiresOn = ""
+		token.Resource = resource
+	}
+
+	return adal.NewServicePrincipalTokenFromManualToken(oauthConfig, clientID, resource, token)
+}
@@ -0,0 +1,179 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func setAzureCLIConfigDir(t *testing.T, dir string) func() {
+	previous := os.Getenv("AZURE_CONFIG_DIR")
+	if err := os.Setenv("AZURE_CONFIG_DIR", dir); err != nil {
+		t.Fatalf("Error setting AZURE_CONFIG_DIR: %+v", err)
+	}
+
+	return func() {
+		os.Setenv("AZURE_CONFIG_DIR", previous)
+	}
+}
+
+func TestAzureCLITokens_DefaultSubscription(t *testing.T) {
+	defer setAzureCLIConfigDir(t, "test-fixtures/azure-cli")()
+
+	config := &Config{
+		Environment: "public",
+	}
+	if err := config.loadTokensFromAzureCLI(); err != nil {
+		t.Fatalf("Error loading the Azure CLI tokens: %+v", err)
+	}
+
+	if config.SubscriptionID != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("Expected the default Subscription ID but got %q", config.SubscriptionID)
+	}
+	if config.TenantID != "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb" {
+		t.Fatalf("Expected the Tenant ID of the default Subscription but got %q", config.TenantID)
+	}
+	if config.ClientID != "04b07795-8ddb-461a-bbee-02f9e1bf7b46" {
+		t.Fatalf("Expected the Azure CLI's Client ID but got %q", config.ClientID)
+	}
+	if config.AccessToken == nil {
+		t.Fatalf("Expected an Access Token to be loaded but got nil")
+	}
+	if config.AccessToken.AccessToken != "production-access-token" {
+		t.Fatalf("Expected the most recent Access Token for the Tenant but got %q", config.AccessToken.AccessToken)
+	}
+	if config.AccessToken.RefreshToken != "production-refresh-token" {
+		t.Fatalf("Expected the Refresh Token to be loaded but got %q", config.AccessToken.RefreshToken)
+	}
+
+	if err := config.validate(); err != nil {
+		t.Fatalf("Expected the Config to be valid but got: %+v", err)
+	}
+}
+
+func TestAzureCLITokens_SpecifiedSubscription(t *testing.T) {
+	defer setAzureCLIConfigDir(t, "test-fixtures/azure-cli")()
+
+	config := &Config{
+		SubscriptionID: "11111111-1111-1111-1111-111111111111",
+		Environment:    "public",
+	}
+	if err := config.loadTokensFromAzureCLI(); err != nil {
+		t.Fatalf("Error loading the Azure CLI tokens: %+v", err)
+	}
+
+	if config.TenantID != "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa" {
+		t.Fatalf("Expected the Tenant ID of the specified Subscription but got %q", config.TenantID)
+	}
+	if config.AccessToken.AccessToken != "dev-access-token" {
+		t.Fatalf("Expected the Access Token for the specified Tenant but got %q", config.AccessToken.AccessToken)
+	}
+}
+
+func TestAzureCLITokens_UnknownSubscription(t *testing.T) {
+	defer setAzureCLIConfigDir(t, "test-fixtures/azure-cli")()
+
+	config := &Config{
+		SubscriptionID: "33333333-3333-3333-3333-333333333333",
+		Environment:    "public",
+	}
+	if err := config.loadTokensFromAzureCLI(); err == nil {
+		t.Fatalf("Expected an error for a Subscription not in the Azure CLI Profile")
+	}
+}
+
+func TestAzureCLITokens_MissingProfile(t *testing.T) {
+	defer setAzureCLIConfigDir(t, "test-fixtures/does-not-exist")()
+
+	config := &Config{
+		Environment: "public",
+	}
+	if err := config.loadTokensFromAzureCLI(); err == nil {
+		t.Fatalf("Expected an error when the Azure CLI Profile doesn't exist")
+	}
+}
+
+func TestAzureCLITokens_ServicePrincipalToken(t *testing.T) {
+	token := adal.Token{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+		ExpiresOn:    "4102444800",
+		Resource:     "https://management.core.windows.net/",
+		Type:         "Bearer",
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig("https://login.microsoftonline.com/", "tenant")
+	if err != nil {
+		t.Fatalf("Error building the OAuth Config: %+v", err)
+	}
+
+	armToken, err := newServicePrincipalTokenFromAzureCLI(*oauthConfig, "client", "https://management.azure.com/", token, "https://management.core.windows.net/")
+	if err != nil {
+		t.Fatalf("Error building the ARM token: %+v", err)
+	}
+	if armToken.OAuthToken() != "access-token" {
+		t.Fatalf("Expected the ARM token to reuse the Access Token but got %q", armToken.OAuthToken())
+	}
+
+	graphToken, err := newServicePrincipalTokenFromAzureCLI(*oauthConfig, "client", "https://graph.windows.net/", token)
+	if err != nil {
+		t.Fatalf("Error building the Graph token: %+v", err)
+	}
+	if graphToken.OAuthToken() != "" {
+		t.Fatalf("Expected the Graph token to require a refresh but got %q", graphToken.OAuthToken())
+	}
+	if !graphToken.IsExpired() {
+		t.Fatalf("Expected the Graph token to be expired")
+	}
+}
+
+func TestAzureCLITokens_RefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Error parsing the token request: %+v", err)
+		}
+
+		if grantType := r.PostForm.Get("grant_type"); grantType != "refresh_token" {
+			t.Fatalf("Expected a `refresh_token` grant but got %q", grantType)
+		}
+		if refreshToken := r.PostForm.Get("refresh_token"); refreshToken != "refresh-token" {
+			t.Fatalf("Expected the Azure CLI's Refresh Token but got %q", refreshToken)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"graph-access-token","refresh_token":"new-refresh-token","expires_on":"4102444800","resource":%q,"token_type":"Bearer"}`, r.PostForm.Get("resource"))
+	}))
+	defer server.Close()
+
+	tokenEndpoint, _ := url.Parse(server.URL + "/tenant/oauth2/token")
+	oauthConfig := adal.OAuthConfig{
+		TokenEndpoint: *tokenEndpoint,
+	}
+
+	token := adal.Token{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+		ExpiresOn:    "4102444800",
+		Resource:     "https://management.core.windows.net/",
+		Type:         "Bearer",
+	}
+	graphToken, err := newServicePrincipalTokenFromAzureCLI(oauthConfig, "client", "https://graph.windows.net/", token)
+	if err != nil {
+		t.Fatalf("Error building the Graph token: %+v", err)
+	}
+
+	if err := graphToken.EnsureFresh(); err != nil {
+		t.Fatalf("Error refreshing the Graph token: %+v", err)
+	}
+	if graphToken.OAuthToken() != "graph-access-token" {
+		t.Fatalf("Expected the refreshed Access Token but got %q", graphToken.OAuthToken())
+	}
+	if graphToken.Resource != "https://graph.windows.net/" {
+		t.Fatalf("Expected the refreshed token to be for the Graph API but got %q", graphToken.Resource)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -36,22 +38,30 @@ func buildConfigForSweepers() (*ArmClient, error) {
 		TenantID:                 tenantID,
 		Environment:              environment,
 		SkipProviderRegistration: false,
+		MaxRetries:               defaultMaxRetries,
 	}
 
 	return config.getArmClient()
 }
 
+// acceptanceTestPrefixes are the (lower-cased) prefixes of the names of the Resource Groups created by the
+// acceptance tests, e.g. `acctestRG-{number}`, `acctest-rg-{number}` or `testAccRg-{number}`.
+var acceptanceTestPrefixes = []string{"acctest", "testacc"}
+
 func shouldSweepAcceptanceTestResource(name string, resourceLocation string, region string) bool {
 	loweredName := strings.ToLower(name)
 
-	prefixesToIgnore := []string{"acctest"}
-
-	for _, prefix := range prefixesToIgnore {
-		if !strings.HasPrefix(loweredName, prefix) {
-			log.Printf("Ignoring Resource '%s' due to prefix '%s'", name, prefix)
-			return false
+	hasPrefix := false
+	for _, prefix := range acceptanceTestPrefixes {
+		if strings.HasPrefix(loweredName, prefix) {
+			hasPrefix = true
+			break
 		}
 	}
+	if !hasPrefix {
+		log.Printf("Ignoring Resource '%s' since it doesn't have one of the prefixes %+v", name, acceptanceTestPrefixes)
+		return false
+	}
 
 	normalisedResourceLocation := azureRMNormalizeLocation(resourceLocation)
 	normalisedRegion := azureRMNormalizeLocation(region)
@@ -63,3 +73,119 @@ func shouldSweepAcceptanceTestResource(name string, resourceLocation string, reg
 
 	return true
 }
+
+// sweepableResource is a top-level resource which may have been left behind by the acceptance tests.
+type sweepableResource struct {
+	id       *string
+	name     *string
+	location *string
+}
+
+// sweepAcceptanceTestResources deletes each of the resources in the region which are within a Resource
+// Group created by the acceptance tests - since not every resource is named with the `acctest` prefix
+// (e.g. where the name's restricted to alphanumeric characters). Failing to delete one resource doesn't
+// stop the others from being swept - instead all of the errors are returned once every resource has been
+// tried.
+func sweepAcceptanceTestResources(region string, description string, resources []sweepableResource, delete func(resourceGroup string, name string) error) error {
+	var errors *multierror.Error
+
+	for _, r := range resources {
+		if r.id == nil || r.name == nil || r.location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*r.id)
+		if err != nil {
+			errors = multierror.Append(errors, err)
+			continue
+		}
+
+		if !shouldSweepAcceptanceTestResource(id.ResourceGroup, *r.location, region) {
+			continue
+		}
+
+		log.Printf("Deleting %s '%s' in Resource Group '%s'", description, *r.name, id.ResourceGroup)
+		if err := delete(id.ResourceGroup, *r.name); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting %s %q (Resource Group %q): %+v", description, *r.name, id.ResourceGroup, err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+// acceptanceTestResourceGroups returns the names of the Resource Groups in the region which were created
+// by the acceptance tests, which is used to sweep resources that can only be listed within a Resource Group.
+func acceptanceTestResourceGroups(armClient *ArmClient, region string) ([]string, error) {
+	client := armClient.resourceGroupClient()
+	names := make([]string, 0)
+
+	results, err := client.List("", nil)
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Resource Groups: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, group := range *results.Value {
+				if group.Name != nil && group.Location != nil && shouldSweepAcceptanceTestResource(*group.Name, *group.Location, region) {
+					names = append(names, *group.Name)
+				}
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return names, nil
+}
+
+func TestSweepAcceptanceTestResources(t *testing.T) {
+	resourceID := func(resourceGroup, name string) *string {
+		id := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s", resourceGroup, name)
+		return &id
+	}
+	sweepable := func(resourceGroup, name, location string) sweepableResource {
+		return sweepableResource{resourceID(resourceGroup, name), &name, &location}
+	}
+
+	resources := []sweepableResource{
+		sweepable("acctestRG-1", "acctestvn-1", "West Europe"),
+		sweepable("acctestRG-2", "acctestvn-2", "westeurope"),
+		sweepable("production", "production-vn", "westeurope"),
+		sweepable("acctestRG-3", "acctestvn-3", "eastus"),
+		sweepable("acctestRG-4", "storageaccount4", "westeurope"),
+		sweepable("prod-acctest", "acctestvn-5", "westeurope"),
+		sweepable("acctest-rg-6", "acctestvn-6", "westeurope"),
+		sweepable("testAccRg-7", "testvn7", "westeurope"),
+		sweepable("acctest8-rg", "acctestvn-8", "westeurope"),
+	}
+
+	deleted := make([]string, 0)
+	err := sweepAcceptanceTestResources("westeurope", "Virtual Network", resources, func(resourceGroup string, name string) error {
+		deleted = append(deleted, fmt.Sprintf("%s/%s", resourceGroup, name))
+		if name == "acctestvn-1" {
+			return fmt.Errorf("the Virtual Network is in use")
+		}
+		return nil
+	})
+
+	// the failure to delete the first resource doesn't stop the others being swept
+	if err == nil || !strings.Contains(err.Error(), "acctestvn-1") {
+		t.Fatalf("Expected an error deleting acctestvn-1 but got: %+v", err)
+	}
+
+	expected := []string{
+		"acctestRG-1/acctestvn-1",
+		"acctestRG-2/acctestvn-2",
+		"acctestRG-4/storageaccount4",
+		"acctest-rg-6/acctestvn-6",
+		"testAccRg-7/testvn7",
+		"acctest8-rg/acctestvn-8",
+	}
+	if !reflect.DeepEqual(expected, deleted) {
+		t.Fatalf("Expected %+v to be deleted but got %+v", expected, deleted)
+	}
+}
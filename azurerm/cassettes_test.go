@@ -0,0 +1,458 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The traffic sent to Azure by the acceptance tests can be recorded into a "cassette", which can then
+// be replayed offline without any credentials. This is controlled by `ARM_TEST_CASSETTE_MODE`:
+//
+//   - `record` runs the tests against Azure as usual, writing the (scrubbed) traffic from each test
+//     to `test-fixtures/cassettes/{TestName}.json`
+//   - `replay` serves the responses from the cassette instead, which only requires `TF_ACC` and
+//     `ARM_TEST_LOCATION` (matching the location used to record it) to be set
+//
+// Since requests are matched on their Method and URL, the names of the resources need to match those
+// which were recorded - as such tests need to use `acctRandInt` (and friends) rather than `acctest`.
+const (
+	cassetteModeEnvVar = "ARM_TEST_CASSETTE_MODE"
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+
+	// cassetteSubscriptionID replaces the Subscription, Tenant & Client ID's in the recorded traffic
+	cassetteSubscriptionID = "00000000-0000-0000-0000-000000000000"
+
+	// cassetteScrubbedSecret replaces secrets in the recorded traffic. Since Storage Account Keys are
+	// decoded by the Storage data-plane clients this is a valid base64 value ("scrubbed").
+	cassetteScrubbedSecret = "c2NydWJiZWQ="
+)
+
+var cassetteDirectory = filepath.Join("test-fixtures", "cassettes")
+
+func cassetteMode() string {
+	return strings.ToLower(os.Getenv(cassetteModeEnvVar))
+}
+
+// cassetteInteraction is a request to Azure and the (scrubbed) response which was returned.
+type cassetteInteraction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers"`
+	Body       string      `json:"body"`
+}
+
+type cassette struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// cassetteRecorder is an autorest.Sender which records the traffic sent to Azure, saving the
+// cassette after every interaction since there's no hook once a test completes.
+type cassetteRecorder struct {
+	path     string
+	sender   autorest.Sender
+	scrubber *strings.Replacer
+
+	lock     sync.Mutex
+	cassette cassette
+}
+
+// newCassetteRecorder returns a recorder which replaces the specified ID's (such as the Subscription
+// ID) in the recorded traffic.
+func newCassetteRecorder(path string, ids ...string) *cassetteRecorder {
+	replacements := make([]string, 0)
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		replacements = append(replacements, id, cassetteSubscriptionID, strings.ToLower(id), cassetteSubscriptionID, strings.ToUpper(id), cassetteSubscriptionID)
+	}
+
+	return &cassetteRecorder{
+		path:     path,
+		sender:   &http.Client{},
+		scrubber: strings.NewReplacer(replacements...),
+	}
+}
+
+func (r *cassetteRecorder) Do(req *http.Request) (*http.Response, error) {
+	resp, sendErr := r.sender.Do(req)
+	if resp == nil {
+		return resp, sendErr
+	}
+
+	body := []byte{}
+	if resp.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	headers := http.Header{}
+	for key, values := range resp.Header {
+		for _, value := range values {
+			headers.Add(key, r.scrubber.Replace(value))
+		}
+	}
+	for _, header := range redactedHeaders {
+		headers.Del(header)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, cassetteInteraction{
+		Method:     req.Method,
+		URL:        r.scrubber.Replace(req.URL.String()),
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		Body:       r.scrubber.Replace(scrubCassetteBody(body)),
+	})
+
+	if err := r.save(); err != nil {
+		return resp, fmt.Errorf("Error saving the cassette %q: %+v", r.path, err)
+	}
+
+	return resp, sendErr
+}
+
+func (r *cassetteRecorder) save() error {
+	output, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, output, 0644)
+}
+
+// scrubCassetteBody redacts any secrets within a JSON body, in the same way as the request logging.
+// Non-JSON bodies (such as from the Storage data-plane) are recorded as-is.
+func scrubCassetteBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return string(body)
+	}
+
+	scrubbed, err := json.Marshal(redactJSON(parsed, false))
+	if err != nil {
+		return string(body)
+	}
+
+	redacted, _ := json.Marshal(redactedValue)
+	placeholder, _ := json.Marshal(cassetteScrubbedSecret)
+	return string(bytes.Replace(scrubbed, redacted, placeholder, -1))
+}
+
+// cassettePlayer is an autorest.Sender which returns the responses recorded in a cassette, in the
+// order they were recorded for each Method and URL.
+type cassettePlayer struct {
+	lock         sync.Mutex
+	interactions map[string][]cassetteInteraction
+}
+
+func loadCassettePlayer(path string) (*cassettePlayer, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var recorded cassette
+	if err := json.Unmarshal(contents, &recorded); err != nil {
+		return nil, fmt.Errorf("Error parsing the cassette %q: %+v", path, err)
+	}
+
+	player := &cassettePlayer{
+		interactions: make(map[string][]cassetteInteraction),
+	}
+	for _, interaction := range recorded.Interactions {
+		key := cassetteKey(interaction.Method, interaction.URL)
+		player.interactions[key] = append(player.interactions[key], interaction)
+	}
+
+	return player, nil
+}
+
+func cassetteKey(method, url string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), url)
+}
+
+func (p *cassettePlayer) Do(req *http.Request) (*http.Response, error) {
+	key := cassetteKey(req.Method, req.URL.String())
+
+	p.lock.Lock()
+	queue := p.interactions[key]
+	if len(queue) == 0 {
+		p.lock.Unlock()
+		return nil, fmt.Errorf("No recorded response remains for %s", key)
+	}
+	interaction := queue[0]
+	p.interactions[key] = queue[1:]
+	p.lock.Unlock()
+
+	headers := http.Header{}
+	for k, v := range interaction.Headers {
+		headers[k] = v
+	}
+	// there's no need to wait between polling requests when replaying
+	headers.Set("Retry-After", "0")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(interaction.Body)),
+		ContentLength: int64(len(interaction.Body)),
+		Request:       req,
+	}, nil
+}
+
+// configureCassette replaces the ConfigureFunc of the test Provider to record or replay the traffic
+// for the current test, when enabled.
+func configureCassette(t *testing.T) {
+	if !cassettesEnabled() {
+		return
+	}
+
+	path := filepath.Join(cassetteDirectory, fmt.Sprintf("%s.json", t.Name()))
+
+	if cassetteMode() == cassetteModeRecord {
+		var recorder *cassetteRecorder
+		testAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+			config := expandProviderConfig(d)
+			if recorder == nil {
+				recorder = newCassetteRecorder(path, config.SubscriptionID, config.TenantID, config.ClientID)
+			}
+			config.sender = recorder
+			return configureProvider(testAccProvider, config)
+		}
+		return
+	}
+
+	player, err := loadCassettePlayer(path)
+	if err != nil {
+		t.Fatalf("Error loading the cassette for %q: %+v", t.Name(), err)
+	}
+
+	testAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config := expandProviderConfig(d)
+		config.SubscriptionID = cassetteSubscriptionID
+		config.TenantID = cassetteSubscriptionID
+		config.ClientID = cassetteSubscriptionID
+		config.skipAuthentication = true
+		config.sender = player
+		return configureProvider(testAccProvider, config)
+	}
+}
+
+var (
+	testRandLock    sync.Mutex
+	testRandSources = map[string]*rand.Rand{}
+)
+
+// testRand returns the source of random numbers for the test, which is seeded from the name of
+// the test when recording or replaying so that the same names are generated each time.
+func testRand(t *testing.T) *rand.Rand {
+	testRandLock.Lock()
+	defer testRandLock.Unlock()
+
+	if source, ok := testRandSources[t.Name()]; ok {
+		return source
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(t.Name()))
+	source := rand.New(rand.NewSource(int64(hash.Sum64())))
+	testRandSources[t.Name()] = source
+	return source
+}
+
+func cassettesEnabled() bool {
+	mode := cassetteMode()
+	return mode == cassetteModeRecord || mode == cassetteModeReplay
+}
+
+// acctRandInt is a replacement for `acctest.RandInt` which generates the same values when
+// recording & replaying cassettes.
+func acctRandInt(t *testing.T) int {
+	if !cassettesEnabled() {
+		return acctest.RandInt()
+	}
+
+	return testRand(t).Int()
+}
+
+// acctRandString is a replacement for `acctest.RandString` which generates the same values when
+// recording & replaying cassettes.
+func acctRandString(t *testing.T, length int) string {
+	return acctRandStringFromCharSet(t, length, acctest.CharSetAlphaNum)
+}
+
+// acctRandStringFromCharSet is a replacement for `acctest.RandStringFromCharSet` which generates the
+// same values when recording & replaying cassettes.
+func acctRandStringFromCharSet(t *testing.T, length int, charSet string) string {
+	if !cassettesEnabled() {
+		return acctest.RandStringFromCharSet(length, charSet)
+	}
+
+	source := testRand(t)
+	result := make([]byte, length)
+	for i := 0; i < length; i++ {
+		result[i] = charSet[source.Intn(len(charSet))]
+	}
+	return string(result)
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	subscriptionID := "11111111-2222-3333-4444-555555555555"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc123")
+		w.Header().Set("Location", fmt.Sprintf("https://management.azure.com/subscriptions/%s/operationResults/1", subscriptionID))
+		fmt.Fprint(w, `{"keys":[{"keyName":"key1","value":"c2VjcmV0"}],"sharedKey":"hunter2"}`)
+	}))
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("Error creating the temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "TestExample.json")
+
+	url := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys", server.URL, strings.ToUpper(subscriptionID))
+	recorder := newCassetteRecorder(path, subscriptionID)
+
+	req, _ := http.NewRequest(http.MethodPost, url, nil)
+	resp, err := recorder.Do(req)
+	if err != nil {
+		t.Fatalf("Error recording the request: %+v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "hunter2") {
+		t.Fatalf("Expected the unscrubbed response to be returned whilst recording but got %q", body)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading the cassette: %+v", err)
+	}
+	for _, secret := range []string{subscriptionID, strings.ToUpper(subscriptionID), "hunter2", "c2VjcmV0", "abc123"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("Expected %q to be scrubbed from the cassette:\n%s", secret, contents)
+		}
+	}
+
+	player, err := loadCassettePlayer(path)
+	if err != nil {
+		t.Fatalf("Error loading the cassette: %+v", err)
+	}
+
+	// the replayed request uses the placeholder Subscription ID
+	replayURL := strings.Replace(url, strings.ToUpper(subscriptionID), cassetteSubscriptionID, -1)
+	req, _ = http.NewRequest(http.MethodPost, replayURL, nil)
+	resp, err = player.Do(req)
+	if err != nil {
+		t.Fatalf("Error replaying the request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := resp.Header.Get("Retry-After"); actual != "0" {
+		t.Fatalf("Expected the Retry-After header to be zero when replaying but got %q", actual)
+	}
+	expectedLocation := fmt.Sprintf("https://management.azure.com/subscriptions/%s/operationResults/1", cassetteSubscriptionID)
+	if actual := resp.Header.Get("Location"); actual != expectedLocation {
+		t.Fatalf("Expected the Location header to be %q but got %q", expectedLocation, actual)
+	}
+	body, _ = ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), cassetteScrubbedSecret) {
+		t.Fatalf("Expected the secrets to be replaced with the placeholder but got %q", body)
+	}
+
+	// each recorded response is only replayed once
+	req, _ = http.NewRequest(http.MethodPost, replayURL, nil)
+	if _, err := player.Do(req); err == nil {
+		t.Fatalf("Expected an error replaying a request which wasn't recorded")
+	}
+}
+
+func TestCassette_RecordReturnsSenderErrors(t *testing.T) {
+	directory, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("Error creating the temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	expected := fmt.Errorf("stopped after 10 redirects")
+	recorder := newCassetteRecorder(filepath.Join(directory, "TestExample.json"))
+	recorder.sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusFound,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}, expected
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	resp, err := recorder.Do(req)
+	if err != expected {
+		t.Fatalf("Expected the error from the sender to be returned but got: %+v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("Expected the response from the sender to be returned")
+	}
+}
+
+func TestAcctRandInt_DeterministicWithCassettes(t *testing.T) {
+	mode := os.Getenv(cassetteModeEnvVar)
+	defer os.Setenv(cassetteModeEnvVar, mode)
+	os.Setenv(cassetteModeEnvVar, cassetteModeReplay)
+
+	first := []interface{}{acctRandInt(t), acctRandString(t, 8)}
+
+	// another run of the same test generates the same values
+	testRandLock.Lock()
+	delete(testRandSources, t.Name())
+	testRandLock.Unlock()
+
+	second := []interface{}{acctRandInt(t), acctRandString(t, 8)}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("Expected the same values to be generated for the same test but got %+v and %+v", first, second)
+	}
+	if first[0] == acctRandInt(t) {
+		t.Fatalf("Expected successive values within a test to differ")
+	}
+}
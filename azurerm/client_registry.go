@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// clientFactory builds an SDK client configured using the specified options.
+type clientFactory func(o *clientOptions) interface{}
+
+// clientFactories contains the factories registered by each service, keyed by the name of the client.
+var clientFactories = map[string]clientFactory{}
+
+// registerClientFactory registers the factory used to build the named client - which is called
+// from an `init` function within the file for each service (e.g. `clients_compute.go`), such that
+// new services can be added in one place.
+func registerClientFactory(name string, factory clientFactory) {
+	if _, exists := clientFactories[name]; exists {
+		panic(fmt.Sprintf("A client factory has already been registered for %q", name))
+	}
+
+	clientFactories[name] = factory
+}
+
+// clientOptions contains the values required to build & configure the SDK clients.
+type clientOptions struct {
+	subscriptionId string
+	tenantId       string
+
+	resourceManagerEndpoint   string
+	resourceManagerAuthorizer autorest.Authorizer
+
+	graphEndpoint   string
+	graphAuthorizer autorest.Authorizer
+
+	// configureClient sets the User Agent, Authorizer and Sender used by the SDK client
+	configureClient func(client *autorest.Client, auth autorest.Authorizer)
+}
+
+// clientRegistry lazily builds the SDK clients, such that each factory is only called
+// the first time the client is needed by a resource.
+type clientRegistry struct {
+	options *clientOptions
+
+	lock    sync.Mutex
+	clients map[string]interface{}
+}
+
+func newClientRegistry(options *clientOptions) *clientRegistry {
+	return &clientRegistry{
+		options: options,
+		clients: make(map[string]interface{}),
+	}
+}
+
+// get returns the named client, building it using the registered factory if it doesn't exist.
+func (r *clientRegistry) get(name string) interface{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if client, ok := r.clients[name]; ok {
+		return client
+	}
+
+	factory, ok := clientFactories[name]
+	if !ok {
+		// this is a programming error, rather than something a user can fix
+		panic(fmt.Sprintf("No client factory has been registered for %q", name))
+	}
+
+	log.Printf("[DEBUG] Building the %q client", name)
+	client := factory(r.options)
+	r.clients[name] = client
+	return client
+}
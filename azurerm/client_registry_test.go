@@ -0,0 +1,76 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testClientOptions(configured *int) *clientOptions {
+	return &clientOptions{
+		subscriptionId:            "00000000-0000-0000-0000-000000000000",
+		tenantId:                  "11111111-1111-1111-1111-111111111111",
+		resourceManagerEndpoint:   "https://management.azure.com/",
+		resourceManagerAuthorizer: autorest.NullAuthorizer{},
+		graphEndpoint:             "https://graph.windows.net/",
+		graphAuthorizer:           autorest.NullAuthorizer{},
+		configureClient: func(client *autorest.Client, auth autorest.Authorizer) {
+			*configured++
+			client.Authorizer = auth
+		},
+	}
+}
+
+func TestClientFactories_Build(t *testing.T) {
+	if len(clientFactories) == 0 {
+		t.Fatalf("Expected client factories to be registered")
+	}
+
+	for name, factory := range clientFactories {
+		configured := 0
+		if client := factory(testClientOptions(&configured)); client == nil {
+			t.Fatalf("Expected the factory for %q to return a client", name)
+		}
+		if configured != 1 {
+			t.Fatalf("Expected the %q client to be configured once but it was configured %d times", name, configured)
+		}
+	}
+}
+
+func TestClientRegistry_BuildsClientsOnFirstUse(t *testing.T) {
+	configured := 0
+	client := &ArmClient{
+		clients: newClientRegistry(testClientOptions(&configured)),
+	}
+
+	if configured != 0 {
+		t.Fatalf("Expected no clients to be built until they're used, but %d were built", configured)
+	}
+
+	vmClient := client.vmClient()
+	if vmClient.BaseURI != "https://management.azure.com/" || vmClient.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected the client to be built using the options but got %q / %q", vmClient.BaseURI, vmClient.SubscriptionID)
+	}
+
+	client.vmClient()
+	if configured != 1 {
+		t.Fatalf("Expected the client to be built once but it was built %d times", configured)
+	}
+
+	spClient := client.servicePrincipalsClient()
+	if spClient.BaseURI != "https://graph.windows.net/" || spClient.TenantID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected the Graph client to use the Graph Endpoint & Tenant ID but got %q / %q", spClient.BaseURI, spClient.TenantID)
+	}
+}
+
+func TestRegisterClientFactory_Duplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected registering a duplicate client factory to panic")
+		}
+	}()
+
+	registerClientFactory("compute.VirtualMachinesClient", func(o *clientOptions) interface{} {
+		return nil
+	})
+}
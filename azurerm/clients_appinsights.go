@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/appinsights"
+
+func init() {
+	registerClientFactory("appinsights.ComponentsClient", func(o *clientOptions) interface{} {
+		client := appinsights.NewComponentsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) appInsightsClient() appinsights.ComponentsClient {
+	return c.clients.get("appinsights.ComponentsClient").(appinsights.ComponentsClient)
+}
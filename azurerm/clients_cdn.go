@@ -0,0 +1,25 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/cdn"
+
+func init() {
+	registerClientFactory("cdn.ProfilesClient", func(o *clientOptions) interface{} {
+		client := cdn.NewProfilesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("cdn.EndpointsClient", func(o *clientOptions) interface{} {
+		client := cdn.NewEndpointsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) cdnProfilesClient() cdn.ProfilesClient {
+	return c.clients.get("cdn.ProfilesClient").(cdn.ProfilesClient)
+}
+
+func (c *ArmClient) cdnEndpointsClient() cdn.EndpointsClient {
+	return c.clients.get("cdn.EndpointsClient").(cdn.EndpointsClient)
+}
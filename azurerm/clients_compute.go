@@ -0,0 +1,85 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/compute"
+
+func init() {
+	registerClientFactory("compute.AvailabilitySetsClient", func(o *clientOptions) interface{} {
+		client := compute.NewAvailabilitySetsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.UsageClient", func(o *clientOptions) interface{} {
+		client := compute.NewUsageClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.VirtualMachineExtensionImagesClient", func(o *clientOptions) interface{} {
+		client := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.VirtualMachineExtensionsClient", func(o *clientOptions) interface{} {
+		client := compute.NewVirtualMachineExtensionsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.VirtualMachineImagesClient", func(o *clientOptions) interface{} {
+		client := compute.NewVirtualMachineImagesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.VirtualMachineScaleSetsClient", func(o *clientOptions) interface{} {
+		client := compute.NewVirtualMachineScaleSetsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.VirtualMachinesClient", func(o *clientOptions) interface{} {
+		client := compute.NewVirtualMachinesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("compute.ImagesClient", func(o *clientOptions) interface{} {
+		client := compute.NewImagesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) availSetClient() compute.AvailabilitySetsClient {
+	return c.clients.get("compute.AvailabilitySetsClient").(compute.AvailabilitySetsClient)
+}
+
+func (c *ArmClient) usageOpsClient() compute.UsageClient {
+	return c.clients.get("compute.UsageClient").(compute.UsageClient)
+}
+
+func (c *ArmClient) vmExtensionImageClient() compute.VirtualMachineExtensionImagesClient {
+	return c.clients.get("compute.VirtualMachineExtensionImagesClient").(compute.VirtualMachineExtensionImagesClient)
+}
+
+func (c *ArmClient) vmExtensionClient() compute.VirtualMachineExtensionsClient {
+	return c.clients.get("compute.VirtualMachineExtensionsClient").(compute.VirtualMachineExtensionsClient)
+}
+
+func (c *ArmClient) vmImageClient() compute.VirtualMachineImagesClient {
+	return c.clients.get("compute.VirtualMachineImagesClient").(compute.VirtualMachineImagesClient)
+}
+
+func (c *ArmClient) vmScaleSetClient() compute.VirtualMachineScaleSetsClient {
+	return c.clients.get("compute.VirtualMachineScaleSetsClient").(compute.VirtualMachineScaleSetsClient)
+}
+
+func (c *ArmClient) vmClient() compute.VirtualMachinesClient {
+	return c.clients.get("compute.VirtualMachinesClient").(compute.VirtualMachinesClient)
+}
+
+func (c *ArmClient) imageClient() compute.ImagesClient {
+	return c.clients.get("compute.ImagesClient").(compute.ImagesClient)
+}
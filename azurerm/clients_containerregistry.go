@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/containerregistry"
+
+func init() {
+	registerClientFactory("containerregistry.RegistriesClient", func(o *clientOptions) interface{} {
+		client := containerregistry.NewRegistriesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) containerRegistryClient() containerregistry.RegistriesClient {
+	return c.clients.get("containerregistry.RegistriesClient").(containerregistry.RegistriesClient)
+}
@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/containerservice"
+
+func init() {
+	registerClientFactory("containerservice.ContainerServicesClient", func(o *clientOptions) interface{} {
+		client := containerservice.NewContainerServicesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) containerServicesClient() containerservice.ContainerServicesClient {
+	return c.clients.get("containerservice.ContainerServicesClient").(containerservice.ContainerServicesClient)
+}
@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/cosmos-db"
+
+func init() {
+	registerClientFactory("cosmosdb.DatabaseAccountsClient", func(o *clientOptions) interface{} {
+		client := cosmosdb.NewDatabaseAccountsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) cosmosDBClient() cosmosdb.DatabaseAccountsClient {
+	return c.clients.get("cosmosdb.DatabaseAccountsClient").(cosmosdb.DatabaseAccountsClient)
+}
@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/disk"
+
+func init() {
+	registerClientFactory("disk.DisksClient", func(o *clientOptions) interface{} {
+		client := disk.NewDisksClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) diskClient() disk.DisksClient {
+	return c.clients.get("disk.DisksClient").(disk.DisksClient)
+}
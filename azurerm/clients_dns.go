@@ -0,0 +1,25 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/dns"
+
+func init() {
+	registerClientFactory("dns.RecordSetsClient", func(o *clientOptions) interface{} {
+		client := dns.NewRecordSetsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("dns.ZonesClient", func(o *clientOptions) interface{} {
+		client := dns.NewZonesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) dnsClient() dns.RecordSetsClient {
+	return c.clients.get("dns.RecordSetsClient").(dns.RecordSetsClient)
+}
+
+func (c *ArmClient) zonesClient() dns.ZonesClient {
+	return c.clients.get("dns.ZonesClient").(dns.ZonesClient)
+}
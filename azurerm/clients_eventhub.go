@@ -0,0 +1,35 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/eventhub"
+
+func init() {
+	registerClientFactory("eventhub.EventHubsClient", func(o *clientOptions) interface{} {
+		client := eventhub.NewEventHubsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("eventhub.ConsumerGroupsClient", func(o *clientOptions) interface{} {
+		client := eventhub.NewConsumerGroupsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("eventhub.NamespacesClient", func(o *clientOptions) interface{} {
+		client := eventhub.NewNamespacesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) eventHubClient() eventhub.EventHubsClient {
+	return c.clients.get("eventhub.EventHubsClient").(eventhub.EventHubsClient)
+}
+
+func (c *ArmClient) eventHubConsumerGroupClient() eventhub.ConsumerGroupsClient {
+	return c.clients.get("eventhub.ConsumerGroupsClient").(eventhub.ConsumerGroupsClient)
+}
+
+func (c *ArmClient) eventHubNamespacesClient() eventhub.NamespacesClient {
+	return c.clients.get("eventhub.NamespacesClient").(eventhub.NamespacesClient)
+}
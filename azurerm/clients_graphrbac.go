@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/graphrbac"
+
+func init() {
+	registerClientFactory("graphrbac.ServicePrincipalsClient", func(o *clientOptions) interface{} {
+		client := graphrbac.NewServicePrincipalsClientWithBaseURI(o.graphEndpoint, o.tenantId)
+		o.configureClient(&client.Client, o.graphAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) servicePrincipalsClient() graphrbac.ServicePrincipalsClient {
+	return c.clients.get("graphrbac.ServicePrincipalsClient").(graphrbac.ServicePrincipalsClient)
+}
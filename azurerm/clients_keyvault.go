@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/keyvault"
+
+func init() {
+	registerClientFactory("keyvault.VaultsClient", func(o *clientOptions) interface{} {
+		client := keyvault.NewVaultsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) keyVaultClient() keyvault.VaultsClient {
+	return c.clients.get("keyvault.VaultsClient").(keyvault.VaultsClient)
+}
@@ -0,0 +1,155 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/network"
+
+func init() {
+	registerClientFactory("network.ApplicationGatewaysClient", func(o *clientOptions) interface{} {
+		client := network.NewApplicationGatewaysClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.InterfacesClient", func(o *clientOptions) interface{} {
+		client := network.NewInterfacesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.ExpressRouteCircuitsClient", func(o *clientOptions) interface{} {
+		client := network.NewExpressRouteCircuitsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.LoadBalancersClient", func(o *clientOptions) interface{} {
+		client := network.NewLoadBalancersClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.LocalNetworkGatewaysClient", func(o *clientOptions) interface{} {
+		client := network.NewLocalNetworkGatewaysClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.PublicIPAddressesClient", func(o *clientOptions) interface{} {
+		client := network.NewPublicIPAddressesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.SecurityGroupsClient", func(o *clientOptions) interface{} {
+		client := network.NewSecurityGroupsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.SecurityRulesClient", func(o *clientOptions) interface{} {
+		client := network.NewSecurityRulesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.SubnetsClient", func(o *clientOptions) interface{} {
+		client := network.NewSubnetsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.VirtualNetworkGatewayConnectionsClient", func(o *clientOptions) interface{} {
+		client := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.VirtualNetworkGatewaysClient", func(o *clientOptions) interface{} {
+		client := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.VirtualNetworksClient", func(o *clientOptions) interface{} {
+		client := network.NewVirtualNetworksClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.VirtualNetworkPeeringsClient", func(o *clientOptions) interface{} {
+		client := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.RouteTablesClient", func(o *clientOptions) interface{} {
+		client := network.NewRouteTablesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("network.RoutesClient", func(o *clientOptions) interface{} {
+		client := network.NewRoutesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) appGatewayClient() network.ApplicationGatewaysClient {
+	return c.clients.get("network.ApplicationGatewaysClient").(network.ApplicationGatewaysClient)
+}
+
+func (c *ArmClient) ifaceClient() network.InterfacesClient {
+	return c.clients.get("network.InterfacesClient").(network.InterfacesClient)
+}
+
+func (c *ArmClient) expressRouteCircuitClient() network.ExpressRouteCircuitsClient {
+	return c.clients.get("network.ExpressRouteCircuitsClient").(network.ExpressRouteCircuitsClient)
+}
+
+func (c *ArmClient) loadBalancerClient() network.LoadBalancersClient {
+	return c.clients.get("network.LoadBalancersClient").(network.LoadBalancersClient)
+}
+
+func (c *ArmClient) localNetConnClient() network.LocalNetworkGatewaysClient {
+	return c.clients.get("network.LocalNetworkGatewaysClient").(network.LocalNetworkGatewaysClient)
+}
+
+func (c *ArmClient) publicIPClient() network.PublicIPAddressesClient {
+	return c.clients.get("network.PublicIPAddressesClient").(network.PublicIPAddressesClient)
+}
+
+func (c *ArmClient) secGroupClient() network.SecurityGroupsClient {
+	return c.clients.get("network.SecurityGroupsClient").(network.SecurityGroupsClient)
+}
+
+func (c *ArmClient) secRuleClient() network.SecurityRulesClient {
+	return c.clients.get("network.SecurityRulesClient").(network.SecurityRulesClient)
+}
+
+func (c *ArmClient) subnetClient() network.SubnetsClient {
+	return c.clients.get("network.SubnetsClient").(network.SubnetsClient)
+}
+
+func (c *ArmClient) vnetGatewayConnectionsClient() network.VirtualNetworkGatewayConnectionsClient {
+	return c.clients.get("network.VirtualNetworkGatewayConnectionsClient").(network.VirtualNetworkGatewayConnectionsClient)
+}
+
+func (c *ArmClient) vnetGatewayClient() network.VirtualNetworkGatewaysClient {
+	return c.clients.get("network.VirtualNetworkGatewaysClient").(network.VirtualNetworkGatewaysClient)
+}
+
+func (c *ArmClient) vnetClient() network.VirtualNetworksClient {
+	return c.clients.get("network.VirtualNetworksClient").(network.VirtualNetworksClient)
+}
+
+func (c *ArmClient) vnetPeeringsClient() network.VirtualNetworkPeeringsClient {
+	return c.clients.get("network.VirtualNetworkPeeringsClient").(network.VirtualNetworkPeeringsClient)
+}
+
+func (c *ArmClient) routeTablesClient() network.RouteTablesClient {
+	return c.clients.get("network.RouteTablesClient").(network.RouteTablesClient)
+}
+
+func (c *ArmClient) routesClient() network.RoutesClient {
+	return c.clients.get("network.RoutesClient").(network.RoutesClient)
+}
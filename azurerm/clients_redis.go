@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/redis"
+
+func init() {
+	registerClientFactory("redis.GroupClient", func(o *clientOptions) interface{} {
+		client := redis.NewGroupClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) redisClient() redis.GroupClient {
+	return c.clients.get("redis.GroupClient").(redis.GroupClient)
+}
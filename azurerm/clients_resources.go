@@ -0,0 +1,55 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+
+func init() {
+	registerClientFactory("resources.GroupsClient", func(o *clientOptions) interface{} {
+		client := resources.NewGroupsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("resources.ProvidersClient", func(o *clientOptions) interface{} {
+		client := resources.NewProvidersClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("resources.TagsClient", func(o *clientOptions) interface{} {
+		client := resources.NewTagsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("resources.GroupClient", func(o *clientOptions) interface{} {
+		client := resources.NewGroupClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("resources.DeploymentsClient", func(o *clientOptions) interface{} {
+		client := resources.NewDeploymentsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) resourceGroupClient() resources.GroupsClient {
+	return c.clients.get("resources.GroupsClient").(resources.GroupsClient)
+}
+
+func (c *ArmClient) providers() resources.ProvidersClient {
+	return c.clients.get("resources.ProvidersClient").(resources.ProvidersClient)
+}
+
+func (c *ArmClient) tagsClient() resources.TagsClient {
+	return c.clients.get("resources.TagsClient").(resources.TagsClient)
+}
+
+func (c *ArmClient) resourceFindClient() resources.GroupClient {
+	return c.clients.get("resources.GroupClient").(resources.GroupClient)
+}
+
+func (c *ArmClient) deploymentsClient() resources.DeploymentsClient {
+	return c.clients.get("resources.DeploymentsClient").(resources.DeploymentsClient)
+}
@@ -0,0 +1,25 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/scheduler"
+
+func init() {
+	registerClientFactory("scheduler.JobsClient", func(o *clientOptions) interface{} {
+		client := scheduler.NewJobsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("scheduler.JobCollectionsClient", func(o *clientOptions) interface{} {
+		client := scheduler.NewJobCollectionsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) jobsClient() scheduler.JobsClient {
+	return c.clients.get("scheduler.JobsClient").(scheduler.JobsClient)
+}
+
+func (c *ArmClient) jobsCollectionsClient() scheduler.JobCollectionsClient {
+	return c.clients.get("scheduler.JobCollectionsClient").(scheduler.JobCollectionsClient)
+}
@@ -0,0 +1,15 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/search"
+
+func init() {
+	registerClientFactory("search.ServicesClient", func(o *clientOptions) interface{} {
+		client := search.NewServicesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) searchServicesClient() search.ServicesClient {
+	return c.clients.get("search.ServicesClient").(search.ServicesClient)
+}
@@ -0,0 +1,45 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/servicebus"
+
+func init() {
+	registerClientFactory("servicebus.NamespacesClient", func(o *clientOptions) interface{} {
+		client := servicebus.NewNamespacesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("servicebus.QueuesClient", func(o *clientOptions) interface{} {
+		client := servicebus.NewQueuesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("servicebus.TopicsClient", func(o *clientOptions) interface{} {
+		client := servicebus.NewTopicsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("servicebus.SubscriptionsClient", func(o *clientOptions) interface{} {
+		client := servicebus.NewSubscriptionsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) serviceBusNamespacesClient() servicebus.NamespacesClient {
+	return c.clients.get("servicebus.NamespacesClient").(servicebus.NamespacesClient)
+}
+
+func (c *ArmClient) serviceBusQueuesClient() servicebus.QueuesClient {
+	return c.clients.get("servicebus.QueuesClient").(servicebus.QueuesClient)
+}
+
+func (c *ArmClient) serviceBusTopicsClient() servicebus.TopicsClient {
+	return c.clients.get("servicebus.TopicsClient").(servicebus.TopicsClient)
+}
+
+func (c *ArmClient) serviceBusSubscriptionsClient() servicebus.SubscriptionsClient {
+	return c.clients.get("servicebus.SubscriptionsClient").(servicebus.SubscriptionsClient)
+}
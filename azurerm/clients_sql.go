@@ -0,0 +1,45 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/sql"
+
+func init() {
+	registerClientFactory("sql.ElasticPoolsClient", func(o *clientOptions) interface{} {
+		client := sql.NewElasticPoolsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("sql.DatabasesClient", func(o *clientOptions) interface{} {
+		client := sql.NewDatabasesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("sql.FirewallRulesClient", func(o *clientOptions) interface{} {
+		client := sql.NewFirewallRulesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("sql.ServersClient", func(o *clientOptions) interface{} {
+		client := sql.NewServersClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) sqlElasticPoolsClient() sql.ElasticPoolsClient {
+	return c.clients.get("sql.ElasticPoolsClient").(sql.ElasticPoolsClient)
+}
+
+func (c *ArmClient) sqlDatabasesClient() sql.DatabasesClient {
+	return c.clients.get("sql.DatabasesClient").(sql.DatabasesClient)
+}
+
+func (c *ArmClient) sqlFirewallRulesClient() sql.FirewallRulesClient {
+	return c.clients.get("sql.FirewallRulesClient").(sql.FirewallRulesClient)
+}
+
+func (c *ArmClient) sqlServersClient() sql.ServersClient {
+	return c.clients.get("sql.ServersClient").(sql.ServersClient)
+}
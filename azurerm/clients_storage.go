@@ -0,0 +1,25 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/storage"
+
+func init() {
+	registerClientFactory("storage.AccountsClient", func(o *clientOptions) interface{} {
+		client := storage.NewAccountsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("storage.UsageClient", func(o *clientOptions) interface{} {
+		client := storage.NewUsageClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) storageServiceClient() storage.AccountsClient {
+	return c.clients.get("storage.AccountsClient").(storage.AccountsClient)
+}
+
+func (c *ArmClient) storageUsageClient() storage.UsageClient {
+	return c.clients.get("storage.UsageClient").(storage.UsageClient)
+}
@@ -0,0 +1,25 @@
+package azurerm
+
+import "github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
+
+func init() {
+	registerClientFactory("trafficmanager.ProfilesClient", func(o *clientOptions) interface{} {
+		client := trafficmanager.NewProfilesClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+
+	registerClientFactory("trafficmanager.EndpointsClient", func(o *clientOptions) interface{} {
+		client := trafficmanager.NewEndpointsClientWithBaseURI(o.resourceManagerEndpoint, o.subscriptionId)
+		o.configureClient(&client.Client, o.resourceManagerAuthorizer)
+		return client
+	})
+}
+
+func (c *ArmClient) trafficManagerProfilesClient() trafficmanager.ProfilesClient {
+	return c.clients.get("trafficmanager.ProfilesClient").(trafficmanager.ProfilesClient)
+}
+
+func (c *ArmClient) trafficManagerEndpointsClient() trafficmanager.EndpointsClient {
+	return c.clients.get("trafficmanager.EndpointsClient").(trafficmanager.EndpointsClient)
+}
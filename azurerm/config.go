@@ -2,547 +2,252 @@ package azurerm
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
-	"net/http/httputil"
-
-	"github.com/Azure/azure-sdk-for-go/arm/appinsights"
-	"github.com/Azure/azure-sdk-for-go/arm/cdn"
-	"github.com/Azure/azure-sdk-for-go/arm/compute"
-	"github.com/Azure/azure-sdk-for-go/arm/containerregistry"
-	"github.com/Azure/azure-sdk-for-go/arm/containerservice"
-	"github.com/Azure/azure-sdk-for-go/arm/cosmos-db"
-	"github.com/Azure/azure-sdk-for-go/arm/disk"
-	"github.com/Azure/azure-sdk-for-go/arm/dns"
-	"github.com/Azure/azure-sdk-for-go/arm/eventhub"
-	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
-	"github.com/Azure/azure-sdk-for-go/arm/keyvault"
-	"github.com/Azure/azure-sdk-for-go/arm/network"
-	"github.com/Azure/azure-sdk-for-go/arm/redis"
-	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
-	"github.com/Azure/azure-sdk-for-go/arm/scheduler"
-	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
-	"github.com/Azure/azure-sdk-for-go/arm/sql"
-	"github.com/Azure/azure-sdk-for-go/arm/storage"
-	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
+	"sync"
+
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/terraform"
-	riviera "github.com/jen20/riviera/azure"
+	"golang.org/x/crypto/pkcs12"
 )
 
 // ArmClient contains the handles to all the specific Azure Resource Manager
-// resource classes' respective clients.
+// resource classes' respective clients, which are built on first use.
 type ArmClient struct {
 	clientId       string
 	tenantId       string
 	subscriptionId string
 	environment    azure.Environment
 
-	StopContext context.Context
+	// supportedAPIVersions contains the API Versions supported by custom Environments
+	// (such as Azure Stack), which are used to validate requests before they're sent
+	supportedAPIVersions resourceProviderAPIVersions
 
-	rivieraClient *riviera.Client
+	// providerRegistrations registers the Resource Provider namespaces the first time they're used,
+	// which is nil when `skip_provider_registration` is enabled
+	providerRegistrations *resourceProviderRegistrations
 
-	availSetClient         compute.AvailabilitySetsClient
-	usageOpsClient         compute.UsageClient
-	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient      compute.VirtualMachineExtensionsClient
-	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
-	vmImageClient          compute.VirtualMachineImagesClient
-	vmClient               compute.VirtualMachinesClient
-	imageClient            compute.ImagesClient
+	// maxRetries is the number of times throttled or failed requests are retried
+	maxRetries int
 
-	diskClient     disk.DisksClient
-	cosmosDBClient cosmosdb.DatabaseAccountsClient
+	// sender replaces the HTTP Client used by every client when set, which is used to
+	// record & replay the traffic from the acceptance tests
+	sender autorest.Sender
 
-	appGatewayClient             network.ApplicationGatewaysClient
-	ifaceClient                  network.InterfacesClient
-	expressRouteCircuitClient    network.ExpressRouteCircuitsClient
-	loadBalancerClient           network.LoadBalancersClient
-	localNetConnClient           network.LocalNetworkGatewaysClient
-	publicIPClient               network.PublicIPAddressesClient
-	secGroupClient               network.SecurityGroupsClient
-	secRuleClient                network.SecurityRulesClient
-	subnetClient                 network.SubnetsClient
-	netUsageClient               network.UsagesClient
-	vnetGatewayConnectionsClient network.VirtualNetworkGatewayConnectionsClient
-	vnetGatewayClient            network.VirtualNetworkGatewaysClient
-	vnetClient                   network.VirtualNetworksClient
-	vnetPeeringsClient           network.VirtualNetworkPeeringsClient
-	routeTablesClient            network.RouteTablesClient
-	routesClient                 network.RoutesClient
-	dnsClient                    dns.RecordSetsClient
-	zonesClient                  dns.ZonesClient
+	// defaultTags are the Tags specified in the Provider block, which are merged into
+	// the Tags of every resource which supports them
+	defaultTags map[string]interface{}
 
-	cdnProfilesClient  cdn.ProfilesClient
-	cdnEndpointsClient cdn.EndpointsClient
+	StopContext context.Context
 
-	containerRegistryClient containerregistry.RegistriesClient
-	containerServicesClient containerservice.ContainerServicesClient
+	// clients lazily builds the SDK clients for each service, which are exposed via
+	// the accessors defined alongside each factory (e.g. `vmClient()`)
+	clients *clientRegistry
 
-	eventHubClient              eventhub.EventHubsClient
-	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient    eventhub.NamespacesClient
+	// subscriptionClients caches the ArmClient for each Subscription specified in the
+	// `subscription_id` of a resource, keyed by the lower-cased Subscription ID
+	subscriptionLock    sync.Mutex
+	subscriptionClients map[string]*ArmClient
+}
 
-	providers           resources.ProvidersClient
-	resourceGroupClient resources.GroupsClient
-	tagsClient          resources.TagsClient
-	resourceFindClient  resources.GroupClient
+func setUserAgent(client *autorest.Client) {
+	version := terraform.VersionString()
+	client.UserAgent = fmt.Sprintf("HashiCorp-Terraform-v%s", version)
+}
 
-	jobsClient            scheduler.JobsClient
-	jobsCollectionsClient scheduler.JobCollectionsClient
+// configureClient sets the User Agent, Authorizer and Sender used by each of the SDK clients.
+func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
+	setUserAgent(client)
+	client.Authorizer = auth
+	sender := c.sender
+	if sender == nil {
+		sender = &http.Client{}
+	}
+	client.Sender = autorest.DecorateSender(sender, withRequestLogging(), withRetries(c.maxRetries), withAPIVersionCheck(c), withResourceProviderRegistration(c), withErrorTranslation())
 
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	// retries are handled by the Sender, which also retries throttled requests & dropped connections
+	client.RetryAttempts = 0
+}
 
-	deploymentsClient resources.DeploymentsClient
+// azureEnvironment returns the Azure Cloud Environment matching the configured name, or
+// when a Metadata URL is specified - the custom Environment advertised by it.
+func (c *Config) azureEnvironment() (azure.Environment, error) {
+	if c.MetadataURL != "" {
+		if c.customEnvironment == nil {
+			env, err := loadEnvironmentFromMetadataURL(c.Environment, c.MetadataURL)
+			if err != nil {
+				return azure.Environment{}, err
+			}
+			c.customEnvironment = env
+		}
 
-	redisClient redis.GroupClient
+		return *c.customEnvironment, nil
+	}
 
-	trafficManagerProfilesClient  trafficmanager.ProfilesClient
-	trafficManagerEndpointsClient trafficmanager.EndpointsClient
+	// detect cloud from environment
+	env, envErr := azure.EnvironmentFromName(c.Environment)
+	if envErr != nil {
+		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
+		var innerErr error
+		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
+			return env, envErr
+		}
+	}
 
-	serviceBusNamespacesClient    servicebus.NamespacesClient
-	serviceBusQueuesClient        servicebus.QueuesClient
-	serviceBusTopicsClient        servicebus.TopicsClient
-	serviceBusSubscriptionsClient servicebus.SubscriptionsClient
+	return env, nil
+}
 
-	keyVaultClient keyvault.VaultsClient
+// getServicePrincipalToken returns a token for the specified resource, using either the
+// Client Secret, the Client Certificate or the Access Token loaded from the Azure CLI.
+// Tokens issued for any of the equivalent resources can be used as-is for the specified resource.
+func (c *Config) getServicePrincipalToken(oauthConfig adal.OAuthConfig, resource string, equivalentResources ...string) (*adal.ServicePrincipalToken, error) {
+	if c.ClientSecret != "" {
+		return adal.NewServicePrincipalToken(oauthConfig, c.ClientID, c.ClientSecret, resource)
+	}
 
-	sqlElasticPoolsClient sql.ElasticPoolsClient
+	if c.ClientCertificatePath != "" {
+		certificate, privateKey, err := c.loadClientCertificate()
+		if err != nil {
+			return nil, err
+		}
 
-	appInsightsClient appinsights.ComponentsClient
+		return adal.NewServicePrincipalTokenFromCertificate(oauthConfig, c.ClientID, certificate, privateKey, resource)
+	}
 
-	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+	return newServicePrincipalTokenFromAzureCLI(oauthConfig, c.ClientID, resource, *c.AccessToken, equivalentResources...)
 }
 
-func withRequestLogging() autorest.SendDecorator {
-	return func(s autorest.Sender) autorest.Sender {
-		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
-			} else {
-				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
-			}
+// getAuthorizer returns a Bearer Authorizer for the specified resource, which obtains tokens
+// from either the Managed Service Identity endpoint or using the Service Principal.
+func (c *Config) getAuthorizer(oauthConfig adal.OAuthConfig, resource string, equivalentResources ...string) (autorest.Authorizer, error) {
+	if c.UseMsi {
+		log.Printf("[DEBUG] Using the Managed Service Identity for %q", resource)
+		return autorest.NewBearerAuthorizer(newMSIToken(c.MsiEndpoint, resource, c.ClientID)), nil
+	}
 
-			resp, err := s.Do(r)
-			if resp != nil {
-				// dump response to wire format
-				if dump, err := httputil.DumpResponse(resp, true); err == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
-				} else {
-					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
-				}
-			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
-			}
-			return resp, err
-		})
+	spt, err := c.getServicePrincipalToken(oauthConfig, resource, equivalentResources...)
+	if err != nil {
+		return nil, err
 	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
 }
 
-func setUserAgent(client *autorest.Client) {
-	version := terraform.VersionString()
-	client.UserAgent = fmt.Sprintf("HashiCorp-Terraform-v%s", version)
+// loadClientCertificate reads and decodes the PFX file containing the Client Certificate
+// (and its RSA Private Key) used to authenticate the Service Principal.
+func (c *Config) loadClientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	pfx, err := ioutil.ReadFile(c.ClientCertificatePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %+v", c.ClientCertificatePath, err)
+	}
+
+	privateKey, certificate, err := pkcs12.Decode(pfx, c.ClientCertificatePassword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the Client Certificate %q: %+v", c.ClientCertificatePath, err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("The Client Certificate %q must contain an RSA Private Key", c.ClientCertificatePath)
+	}
+
+	return certificate, rsaPrivateKey, nil
 }
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
 func (c *Config) getArmClient() (*ArmClient, error) {
-	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(c.Environment)
-	if envErr != nil {
-		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
-		var innerErr error
-		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
-		}
+	env, err := c.azureEnvironment()
+	if err != nil {
+		return nil, err
 	}
 
 	// client declarations:
-	client := ArmClient{
+	client := &ArmClient{
 		clientId:       c.ClientID,
 		tenantId:       c.TenantID,
 		subscriptionId: c.SubscriptionID,
 		environment:    env,
+		maxRetries:     c.MaxRetries,
+		defaultTags:    c.DefaultTags,
+		sender:         c.sender,
 	}
 
-	rivieraClient, err := riviera.NewClient(&riviera.AzureResourceManagerCredentials{
-		ClientID:                c.ClientID,
-		ClientSecret:            c.ClientSecret,
-		TenantID:                c.TenantID,
-		SubscriptionID:          c.SubscriptionID,
-		ResourceManagerEndpoint: env.ResourceManagerEndpoint,
-		ActiveDirectoryEndpoint: env.ActiveDirectoryEndpoint,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("Error creating Riviera client: %s", err)
+	var auth, graphAuth autorest.Authorizer
+	if c.resourceManagerEndpoint != "" {
+		// the fake ARM server used in the unit tests doesn't require authentication
+		env.ResourceManagerEndpoint = c.resourceManagerEndpoint
+		env.GraphEndpoint = c.resourceManagerEndpoint
+		client.environment = env
+		auth = autorest.NullAuthorizer{}
+		graphAuth = autorest.NullAuthorizer{}
+	} else if c.skipAuthentication {
+		auth = autorest.NullAuthorizer{}
+		graphAuth = autorest.NullAuthorizer{}
+	} else {
+		auth, graphAuth, err = c.getAuthorizers(env)
+		if err != nil {
+			return nil, err
+		}
 	}
-	client.rivieraClient = rivieraClient
 
+	// the SDK clients are only built the first time they're used - the factory for each client is
+	// registered alongside its accessor (e.g. `clients_compute.go`), where it can be configured
+	// with custom Responders/PollingModes etc...
+	client.clients = newClientRegistry(&clientOptions{
+		subscriptionId:            c.SubscriptionID,
+		tenantId:                  c.TenantID,
+		resourceManagerEndpoint:   env.ResourceManagerEndpoint,
+		resourceManagerAuthorizer: auth,
+		graphEndpoint:             env.GraphEndpoint,
+		graphAuthorizer:           graphAuth,
+		configureClient:           client.configureClient,
+	})
+
+	return client, nil
+}
+
+// getAuthorizers returns the Authorizers used for Resource Manager and the Graph API.
+func (c *Config) getAuthorizers(env azure.Environment) (autorest.Authorizer, autorest.Authorizer, error) {
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// OAuthConfigForTenant returns a pointer, which can be nil.
 	if oauthConfig == nil {
-		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
+		return nil, nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	spt, err := adal.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, env.ResourceManagerEndpoint)
+	armTokenResource := env.ResourceManagerEndpoint
+	if c.MetadataURL != "" {
+		// custom Environments issue tokens for the audience advertised in the metadata
+		armTokenResource = env.ServiceManagementEndpoint
+	}
+
+	auth, err := c.getAuthorizer(*oauthConfig, armTokenResource, env.ServiceManagementEndpoint)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	graphSpt, err := adal.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, env.GraphEndpoint)
+	graphAuth, err := c.getAuthorizer(*oauthConfig, env.GraphEndpoint)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	endpoint := env.ResourceManagerEndpoint
-	auth := autorest.NewBearerAuthorizer(spt)
-	graphEndpoint := env.GraphEndpoint
-	graphAuth := autorest.NewBearerAuthorizer(graphSpt)
-
-	// NOTE: these declarations should be left separate for clarity should the
-	// clients be wished to be configured with custom Responders/PollingModess etc...
-	asc := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&asc.Client)
-	asc.Authorizer = auth
-	asc.Sender = autorest.CreateSender(withRequestLogging())
-	client.availSetClient = asc
-
-	uoc := compute.NewUsageClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&uoc.Client)
-	uoc.Authorizer = auth
-	uoc.Sender = autorest.CreateSender(withRequestLogging())
-	client.usageOpsClient = uoc
-
-	vmeic := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmeic.Client)
-	vmeic.Authorizer = auth
-	vmeic.Sender = autorest.CreateSender(withRequestLogging())
-	client.vmExtensionImageClient = vmeic
-
-	vmec := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmec.Client)
-	vmec.Authorizer = auth
-	vmec.Sender = autorest.CreateSender(withRequestLogging())
-	client.vmExtensionClient = vmec
-
-	vmic := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmic.Client)
-	vmic.Authorizer = auth
-	vmic.Sender = autorest.CreateSender(withRequestLogging())
-	client.vmImageClient = vmic
-
-	vmssc := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmssc.Client)
-	vmssc.Authorizer = auth
-	vmssc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vmScaleSetClient = vmssc
-
-	vmc := compute.NewVirtualMachinesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmc.Client)
-	vmc.Authorizer = auth
-	vmc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vmClient = vmc
-
-	agc := network.NewApplicationGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&agc.Client)
-	agc.Authorizer = auth
-	agc.Sender = autorest.CreateSender(withRequestLogging())
-	client.appGatewayClient = agc
-
-	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&crc.Client)
-	crc.Authorizer = auth
-	crc.Sender = autorest.CreateSender(withRequestLogging())
-	client.containerRegistryClient = crc
-
-	csc := containerservice.NewContainerServicesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&csc.Client)
-	csc.Authorizer = auth
-	csc.Sender = autorest.CreateSender(withRequestLogging())
-	client.containerServicesClient = csc
-
-	cdb := cosmosdb.NewDatabaseAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cdb.Client)
-	cdb.Authorizer = auth
-	cdb.Sender = autorest.CreateSender(withRequestLogging())
-	client.cosmosDBClient = cdb
-
-	dkc := disk.NewDisksClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&dkc.Client)
-	dkc.Authorizer = auth
-	dkc.Sender = autorest.CreateSender(withRequestLogging())
-	client.diskClient = dkc
-
-	img := compute.NewImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&img.Client)
-	img.Authorizer = auth
-	img.Sender = autorest.CreateSender(withRequestLogging())
-	client.imageClient = img
-
-	ehc := eventhub.NewEventHubsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ehc.Client)
-	ehc.Authorizer = auth
-	ehc.Sender = autorest.CreateSender(withRequestLogging())
-	client.eventHubClient = ehc
-
-	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&chcgc.Client)
-	chcgc.Authorizer = auth
-	chcgc.Sender = autorest.CreateSender(withRequestLogging())
-	client.eventHubConsumerGroupClient = chcgc
-
-	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ehnc.Client)
-	ehnc.Authorizer = auth
-	ehnc.Sender = autorest.CreateSender(withRequestLogging())
-	client.eventHubNamespacesClient = ehnc
-
-	ifc := network.NewInterfacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ifc.Client)
-	ifc.Authorizer = auth
-	ifc.Sender = autorest.CreateSender(withRequestLogging())
-	client.ifaceClient = ifc
-
-	erc := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&erc.Client)
-	erc.Authorizer = auth
-	erc.Sender = autorest.CreateSender(withRequestLogging())
-	client.expressRouteCircuitClient = erc
-
-	lbc := network.NewLoadBalancersClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&lbc.Client)
-	lbc.Authorizer = auth
-	lbc.Sender = autorest.CreateSender(withRequestLogging())
-	client.loadBalancerClient = lbc
-
-	lgc := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&lgc.Client)
-	lgc.Authorizer = auth
-	lgc.Sender = autorest.CreateSender(withRequestLogging())
-	client.localNetConnClient = lgc
-
-	pipc := network.NewPublicIPAddressesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&pipc.Client)
-	pipc.Authorizer = auth
-	pipc.Sender = autorest.CreateSender(withRequestLogging())
-	client.publicIPClient = pipc
-
-	sgc := network.NewSecurityGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sgc.Client)
-	sgc.Authorizer = auth
-	sgc.Sender = autorest.CreateSender(withRequestLogging())
-	client.secGroupClient = sgc
-
-	src := network.NewSecurityRulesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&src.Client)
-	src.Authorizer = auth
-	src.Sender = autorest.CreateSender(withRequestLogging())
-	client.secRuleClient = src
-
-	snc := network.NewSubnetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&snc.Client)
-	snc.Authorizer = auth
-	snc.Sender = autorest.CreateSender(withRequestLogging())
-	client.subnetClient = snc
-
-	vgcc := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vgcc.Client)
-	vgcc.Authorizer = auth
-	vgcc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vnetGatewayConnectionsClient = vgcc
-
-	vgc := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vgc.Client)
-	vgc.Authorizer = auth
-	vgc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vnetGatewayClient = vgc
-
-	vnc := network.NewVirtualNetworksClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vnc.Client)
-	vnc.Authorizer = auth
-	vnc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vnetClient = vnc
-
-	vnpc := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vnpc.Client)
-	vnpc.Authorizer = auth
-	vnpc.Sender = autorest.CreateSender(withRequestLogging())
-	client.vnetPeeringsClient = vnpc
-
-	rtc := network.NewRouteTablesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rtc.Client)
-	rtc.Authorizer = auth
-	rtc.Sender = autorest.CreateSender(withRequestLogging())
-	client.routeTablesClient = rtc
-
-	rc := network.NewRoutesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rc.Client)
-	rc.Authorizer = auth
-	rc.Sender = autorest.CreateSender(withRequestLogging())
-	client.routesClient = rc
-
-	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&dn.Client)
-	dn.Authorizer = auth
-	dn.Sender = autorest.CreateSender(withRequestLogging())
-	client.dnsClient = dn
-
-	zo := dns.NewZonesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&zo.Client)
-	zo.Authorizer = auth
-	zo.Sender = autorest.CreateSender(withRequestLogging())
-	client.zonesClient = zo
-
-	rgc := resources.NewGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rgc.Client)
-	rgc.Authorizer = auth
-	rgc.Sender = autorest.CreateSender(withRequestLogging())
-	client.resourceGroupClient = rgc
-
-	pc := resources.NewProvidersClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&pc.Client)
-	pc.Authorizer = auth
-	pc.Sender = autorest.CreateSender(withRequestLogging())
-	client.providers = pc
-
-	tc := resources.NewTagsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tc.Client)
-	tc.Authorizer = auth
-	tc.Sender = autorest.CreateSender(withRequestLogging())
-	client.tagsClient = tc
-
-	rf := resources.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rf.Client)
-	rf.Authorizer = auth
-	rf.Sender = autorest.CreateSender(withRequestLogging())
-	client.resourceFindClient = rf
-
-	jc := scheduler.NewJobsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&jc.Client)
-	jc.Authorizer = auth
-	jc.Sender = autorest.CreateSender(withRequestLogging())
-	client.jobsClient = jc
-
-	jcc := scheduler.NewJobCollectionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&jcc.Client)
-	jcc.Authorizer = auth
-	jcc.Sender = autorest.CreateSender(withRequestLogging())
-	client.jobsCollectionsClient = jcc
-
-	ssc := storage.NewAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ssc.Client)
-	ssc.Authorizer = auth
-	ssc.Sender = autorest.CreateSender(withRequestLogging())
-	client.storageServiceClient = ssc
-
-	suc := storage.NewUsageClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&suc.Client)
-	suc.Authorizer = auth
-	suc.Sender = autorest.CreateSender(withRequestLogging())
-	client.storageUsageClient = suc
-
-	cpc := cdn.NewProfilesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cpc.Client)
-	cpc.Authorizer = auth
-	cpc.Sender = autorest.CreateSender(withRequestLogging())
-	client.cdnProfilesClient = cpc
-
-	cec := cdn.NewEndpointsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cec.Client)
-	cec.Authorizer = auth
-	cec.Sender = autorest.CreateSender(withRequestLogging())
-	client.cdnEndpointsClient = cec
-
-	dc := resources.NewDeploymentsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&dc.Client)
-	dc.Authorizer = auth
-	dc.Sender = autorest.CreateSender(withRequestLogging())
-	client.deploymentsClient = dc
-
-	tmpc := trafficmanager.NewProfilesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tmpc.Client)
-	tmpc.Authorizer = auth
-	tmpc.Sender = autorest.CreateSender(withRequestLogging())
-	client.trafficManagerProfilesClient = tmpc
-
-	tmec := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tmec.Client)
-	tmec.Authorizer = auth
-	tmec.Sender = autorest.CreateSender(withRequestLogging())
-	client.trafficManagerEndpointsClient = tmec
-
-	rdc := redis.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rdc.Client)
-	rdc.Authorizer = auth
-	rdc.Sender = autorest.CreateSender(withRequestLogging())
-	client.redisClient = rdc
-
-	sbnc := servicebus.NewNamespacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbnc.Client)
-	sbnc.Authorizer = auth
-	sbnc.Sender = autorest.CreateSender(withRequestLogging())
-	client.serviceBusNamespacesClient = sbnc
-
-	sbqc := servicebus.NewQueuesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbqc.Client)
-	sbqc.Authorizer = auth
-	sbqc.Sender = autorest.CreateSender(withRequestLogging())
-	client.serviceBusQueuesClient = sbqc
-
-	sbtc := servicebus.NewTopicsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbtc.Client)
-	sbtc.Authorizer = auth
-	sbtc.Sender = autorest.CreateSender(withRequestLogging())
-	client.serviceBusTopicsClient = sbtc
-
-	sbsc := servicebus.NewSubscriptionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbsc.Client)
-	sbsc.Authorizer = auth
-	sbsc.Sender = autorest.CreateSender(withRequestLogging())
-	client.serviceBusSubscriptionsClient = sbsc
-
-	kvc := keyvault.NewVaultsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&kvc.Client)
-	kvc.Authorizer = auth
-	kvc.Sender = autorest.CreateSender(withRequestLogging())
-	client.keyVaultClient = kvc
-
-	sqlepc := sql.NewElasticPoolsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sqlepc.Client)
-	sqlepc.Authorizer = auth
-	sqlepc.Sender = autorest.CreateSender(withRequestLogging())
-	client.sqlElasticPoolsClient = sqlepc
-
-	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ai.Client)
-	ai.Authorizer = auth
-	ai.Sender = autorest.CreateSender(withRequestLogging())
-	client.appInsightsClient = ai
-
-	spc := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, c.TenantID)
-	setUserAgent(&spc.Client)
-	spc.Authorizer = graphAuth
-	spc.Sender = autorest.CreateSender(withRequestLogging())
-	client.servicePrincipalsClient = spc
-
-	return &client, nil
+	return auth, graphAuth, nil
 }
 
 func (armClient *ArmClient) getKeyForStorageAccount(resourceGroupName, storageAccountName string) (string, bool, error) {
-	accountKeys, err := armClient.storageServiceClient.ListKeys(resourceGroupName, storageAccountName)
+	accountKeys, err := armClient.storageServiceClient().ListKeys(resourceGroupName, storageAccountName)
 	if accountKeys.StatusCode == http.StatusNotFound {
 		return "", false, nil
 	}
@@ -560,6 +265,27 @@ func (armClient *ArmClient) getKeyForStorageAccount(resourceGroupName, storageAc
 	return *keys[0].Value, true, nil
 }
 
+// configureStorageClient sends the requests from the Storage data-plane clients through the Sender
+// used to record & replay the acceptance tests, when one's configured.
+func (armClient *ArmClient) configureStorageClient(client *mainStorage.Client) {
+	if armClient.sender == nil {
+		return
+	}
+
+	client.HTTPClient = &http.Client{
+		Transport: senderTransport{sender: armClient.sender},
+	}
+}
+
+// senderTransport adapts an autorest.Sender into an http.RoundTripper
+type senderTransport struct {
+	sender autorest.Sender
+}
+
+func (t senderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return t.sender.Do(r)
+}
+
 func (armClient *ArmClient) getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
 	key, accountExists, err := armClient.getKeyForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -574,6 +300,7 @@ func (armClient *ArmClient) getBlobStorageClientForStorageAccount(resourceGroupN
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
+	armClient.configureStorageClient(&storageClient)
 
 	blobClient := storageClient.GetBlobService()
 	return &blobClient, true, nil
@@ -593,6 +320,7 @@ func (armClient *ArmClient) getFileServiceClientForStorageAccount(resourceGroupN
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
+	armClient.configureStorageClient(&storageClient)
 
 	fileClient := storageClient.GetFileService()
 	return &fileClient, true, nil
@@ -612,6 +340,7 @@ func (armClient *ArmClient) getTableServiceClientForStorageAccount(resourceGroup
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
+	armClient.configureStorageClient(&storageClient)
 
 	tableClient := storageClient.GetTableService()
 	return &tableClient, true, nil
@@ -631,6 +360,7 @@ func (armClient *ArmClient) getQueueServiceClientForStorageAccount(resourceGroup
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
+	armClient.configureStorageClient(&storageClient)
 
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
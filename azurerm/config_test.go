@@ -0,0 +1,214 @@
+package azurerm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+const (
+	testClientCertificatePath     = "test-fixtures/client-certificate/certificate.pfx"
+	testClientCertificatePassword = "Passw0rd1234"
+)
+
+func TestConfigValidate_Credentials(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      *Config
+		expectError bool
+	}{
+		{
+			name:        "No Credentials",
+			config:      &Config{},
+			expectError: true,
+		},
+		{
+			name: "Client Secret",
+			config: &Config{
+				ClientSecret: "secret",
+			},
+			expectError: false,
+		},
+		{
+			name: "Client Certificate",
+			config: &Config{
+				ClientCertificatePath: testClientCertificatePath,
+			},
+			expectError: false,
+		},
+		{
+			name: "Client Certificate with a Password",
+			config: &Config{
+				ClientCertificatePath:     testClientCertificatePath,
+				ClientCertificatePassword: testClientCertificatePassword,
+			},
+			expectError: false,
+		},
+		{
+			name: "Client Certificate Password without a Path",
+			config: &Config{
+				ClientSecret:              "secret",
+				ClientCertificatePassword: testClientCertificatePassword,
+			},
+			expectError: true,
+		},
+		{
+			name: "Azure CLI",
+			config: &Config{
+				AccessToken: &adal.Token{},
+			},
+			expectError: false,
+		},
+		{
+			name: "Managed Service Identity",
+			config: &Config{
+				UseMsi: true,
+			},
+			expectError: false,
+		},
+		{
+			name: "Managed Service Identity with a custom Endpoint",
+			config: &Config{
+				UseMsi:      true,
+				MsiEndpoint: "http://localhost:50342/oauth2/token",
+			},
+			expectError: false,
+		},
+		{
+			name: "MSI Endpoint without enabling MSI",
+			config: &Config{
+				ClientSecret: "secret",
+				MsiEndpoint:  "http://localhost:50342/oauth2/token",
+			},
+			expectError: true,
+		},
+		{
+			name: "Client Secret and Managed Service Identity",
+			config: &Config{
+				ClientSecret: "secret",
+				UseMsi:       true,
+			},
+			expectError: true,
+		},
+		{
+			name: "Client Secret and Client Certificate",
+			config: &Config{
+				ClientSecret:          "secret",
+				ClientCertificatePath: testClientCertificatePath,
+			},
+			expectError: true,
+		},
+		{
+			name: "Client Secret and Azure CLI",
+			config: &Config{
+				ClientSecret: "secret",
+				AccessToken:  &adal.Token{},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.config.SubscriptionID = "00000000-0000-0000-0000-000000000000"
+		tc.config.ClientID = "11111111-1111-1111-1111-111111111111"
+		tc.config.TenantID = "22222222-2222-2222-2222-222222222222"
+		tc.config.Environment = "public"
+
+		err := tc.config.validate()
+		if tc.expectError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.name)
+		}
+		if !tc.expectError && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.name, err)
+		}
+	}
+}
+
+func TestConfigLoadClientCertificate(t *testing.T) {
+	config := Config{
+		ClientCertificatePath:     testClientCertificatePath,
+		ClientCertificatePassword: testClientCertificatePassword,
+	}
+
+	certificate, privateKey, err := config.loadClientCertificate()
+	if err != nil {
+		t.Fatalf("Error loading the Client Certificate: %+v", err)
+	}
+	if certificate.Subject.CommonName != "terraform-acctest" {
+		t.Fatalf("Expected the Common Name to be `terraform-acctest` but got %q", certificate.Subject.CommonName)
+	}
+	if privateKey == nil {
+		t.Fatalf("Expected a Private Key but got nil")
+	}
+}
+
+func TestConfigLoadClientCertificate_InvalidPassword(t *testing.T) {
+	config := Config{
+		ClientCertificatePath:     testClientCertificatePath,
+		ClientCertificatePassword: "not-the-password",
+	}
+
+	if _, _, err := config.loadClientCertificate(); err == nil {
+		t.Fatalf("Expected an error when decoding the Client Certificate with an invalid password")
+	}
+}
+
+func TestConfigLoadClientCertificate_MissingFile(t *testing.T) {
+	config := Config{
+		ClientCertificatePath: "test-fixtures/client-certificate/does-not-exist.pfx",
+	}
+
+	if _, _, err := config.loadClientCertificate(); err == nil {
+		t.Fatalf("Expected an error when the Client Certificate doesn't exist")
+	}
+}
+
+func TestConfigGetServicePrincipalToken_ClientCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Error parsing the token request: %+v", err)
+		}
+
+		if grantType := r.PostForm.Get("grant_type"); grantType != "client_credentials" {
+			t.Fatalf("Expected a `client_credentials` grant but got %q", grantType)
+		}
+		if assertionType := r.PostForm.Get("client_assertion_type"); assertionType != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+			t.Fatalf("Expected a JWT Client Assertion but got %q", assertionType)
+		}
+		if r.PostForm.Get("client_assertion") == "" {
+			t.Fatalf("Expected a signed Client Assertion but got an empty value")
+		}
+		if r.PostForm.Get("client_secret") != "" {
+			t.Fatalf("Expected no Client Secret to be sent")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"certificate-access-token","expires_on":"4102444800","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+
+	tokenEndpoint, _ := url.Parse(server.URL + "/tenant/oauth2/token")
+	oauthConfig := adal.OAuthConfig{
+		TokenEndpoint: *tokenEndpoint,
+	}
+
+	config := Config{
+		ClientID:                  "11111111-1111-1111-1111-111111111111",
+		ClientCertificatePath:     testClientCertificatePath,
+		ClientCertificatePassword: testClientCertificatePassword,
+	}
+	spt, err := config.getServicePrincipalToken(oauthConfig, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("Error building the Service Principal Token: %+v", err)
+	}
+
+	if err := spt.EnsureFresh(); err != nil {
+		t.Fatalf("Error obtaining a token using the Client Certificate: %+v", err)
+	}
+	if spt.OAuthToken() != "certificate-access-token" {
+		t.Fatalf("Expected the Access Token from the token endpoint but got %q", spt.OAuthToken())
+	}
+}
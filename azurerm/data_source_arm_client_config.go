@@ -35,7 +35,7 @@ func dataSourceArmClientConfig() *schema.Resource {
 
 func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	spClient := client.servicePrincipalsClient
+	spClient := client.servicePrincipalsClient()
 	// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
 	// here, we can query for the Service Principal whose appId matches.
 	filter := fmt.Sprintf("appId eq '%s'", client.clientId)
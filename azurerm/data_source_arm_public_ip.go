@@ -47,7 +47,7 @@ func dataSourceArmPublicIP() *schema.Resource {
 }
 
 func dataSourceArmPublicIPRead(d *schema.ResourceData, meta interface{}) error {
-	publicIPClient := meta.(*ArmClient).publicIPClient
+	publicIPClient := meta.(*ArmClient).publicIPClient()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
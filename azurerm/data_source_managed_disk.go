@@ -53,7 +53,7 @@ func dataSourceArmManagedDisk() *schema.Resource {
 }
 
 func dataSourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
-	diskClient := meta.(*ArmClient).diskClient
+	diskClient := meta.(*ArmClient).diskClient()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -0,0 +1,251 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the version of the metadata endpoint supported by Azure Stack
+const armMetadataAPIVersion = "2015-01-01"
+
+// armMetadataEndpoints represents the document returned from the Metadata Endpoint
+// of an Azure Resource Manager instance, such as an Azure Stack Hub.
+type armMetadataEndpoints struct {
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+
+	// Suffixes are only returned by newer versions of the Metadata Endpoint - where they're
+	// not available they're derived from the Resource Manager Endpoint.
+	Suffixes *struct {
+		Storage     string `json:"storage"`
+		KeyVaultDNS string `json:"keyVaultDns"`
+	} `json:"suffixes,omitempty"`
+}
+
+// armMetadataURL returns the URL of the Metadata Endpoint and the Resource Manager Endpoint
+// for the specified value, which can either be the Resource Manager Endpoint itself or the
+// full URL to the Metadata Endpoint.
+func armMetadataURL(value string) (*url.URL, string, error) {
+	metadataURL, err := url.Parse(value)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error parsing the Metadata URL %q: %+v", value, err)
+	}
+	if metadataURL.Scheme == "" || metadataURL.Host == "" {
+		return nil, "", fmt.Errorf("The Metadata URL %q must be an absolute URL (e.g. `https://management.local.azurestack.external/`)", value)
+	}
+
+	resourceManagerEndpoint := fmt.Sprintf("%s://%s/", metadataURL.Scheme, metadataURL.Host)
+
+	if !strings.Contains(strings.ToLower(metadataURL.Path), "/metadata/endpoints") {
+		metadataURL.Path = strings.TrimSuffix(metadataURL.Path, "/") + "/metadata/endpoints"
+	}
+
+	query := metadataURL.Query()
+	if query.Get("api-version") == "" {
+		query.Set("api-version", armMetadataAPIVersion)
+	}
+	metadataURL.RawQuery = query.Encode()
+
+	return metadataURL, resourceManagerEndpoint, nil
+}
+
+// loadEnvironmentFromMetadataURL builds an Azure Environment from the endpoints advertised
+// by the Metadata Endpoint of a Resource Manager instance, such as an Azure Stack Hub.
+func loadEnvironmentFromMetadataURL(name string, value string) (*azure.Environment, error) {
+	metadataURL, resourceManagerEndpoint, err := armMetadataURL(value)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Loading the Environment %q from the Metadata Endpoint %q", name, metadataURL.String())
+	httpClient := http.Client{
+		Timeout: 30 * time.Second,
+	}
+	resp, err := httpClient.Get(metadataURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the Environment from the Metadata Endpoint %q: %+v", metadataURL.String(), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the Environment from the Metadata Endpoint %q: %+v", metadataURL.String(), err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error retrieving the Environment from the Metadata Endpoint %q: Status Code %d: %s", metadataURL.String(), resp.StatusCode, string(body))
+	}
+
+	var metadata armMetadataEndpoints
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing the Environment returned from the Metadata Endpoint %q: %+v", metadataURL.String(), err)
+	}
+
+	return buildEnvironmentFromMetadata(name, resourceManagerEndpoint, metadata)
+}
+
+func buildEnvironmentFromMetadata(name string, resourceManagerEndpoint string, metadata armMetadataEndpoints) (*azure.Environment, error) {
+	if metadata.Authentication.LoginEndpoint == "" {
+		return nil, fmt.Errorf("The Metadata Endpoint for %q didn't return a Login Endpoint", resourceManagerEndpoint)
+	}
+	if len(metadata.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("The Metadata Endpoint for %q didn't return any Token Audiences", resourceManagerEndpoint)
+	}
+
+	// when using ADFS the tenant (`adfs`) is part of the Login Endpoint, however the OAuth
+	// Config requires the tenant to be specified separately
+	activeDirectoryEndpoint := strings.TrimSuffix(metadata.Authentication.LoginEndpoint, "/")
+	activeDirectoryEndpoint = strings.TrimSuffix(activeDirectoryEndpoint, "/adfs") + "/"
+
+	endpointURL, err := url.Parse(resourceManagerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the Resource Manager Endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+
+	// the Resource Manager Endpoint is a subdomain of the region's domain suffix
+	// e.g. `management.local.azurestack.external` -> `local.azurestack.external`
+	host := endpointURL.Hostname()
+	domainSuffix := host
+	if i := strings.Index(host, "."); i > -1 {
+		domainSuffix = host[i+1:]
+	}
+
+	storageSuffix := domainSuffix
+	keyVaultDNSSuffix := fmt.Sprintf("vault.%s", domainSuffix)
+	if metadata.Suffixes != nil {
+		if metadata.Suffixes.Storage != "" {
+			storageSuffix = metadata.Suffixes.Storage
+		}
+		if metadata.Suffixes.KeyVaultDNS != "" {
+			keyVaultDNSSuffix = strings.TrimPrefix(metadata.Suffixes.KeyVaultDNS, ".")
+		}
+	}
+
+	return &azure.Environment{
+		Name:                      name,
+		ManagementPortalURL:       metadata.PortalEndpoint,
+		ServiceManagementEndpoint: metadata.Authentication.Audiences[0],
+		ResourceManagerEndpoint:   resourceManagerEndpoint,
+		ActiveDirectoryEndpoint:   activeDirectoryEndpoint,
+		GalleryEndpoint:           metadata.GalleryEndpoint,
+		KeyVaultEndpoint:          fmt.Sprintf("https://%s/", keyVaultDNSSuffix),
+		GraphEndpoint:             metadata.GraphEndpoint,
+		StorageEndpointSuffix:     storageSuffix,
+		KeyVaultDNSSuffix:         keyVaultDNSSuffix,
+	}, nil
+}
+
+// resourceProviderAPIVersions contains the API Versions supported by each Resource Type
+// within each Resource Provider, keyed by the lower-cased Namespace & Resource Type.
+type resourceProviderAPIVersions map[string]map[string][]string
+
+func newResourceProviderAPIVersions(providers []resources.Provider) resourceProviderAPIVersions {
+	output := make(resourceProviderAPIVersions, len(providers))
+
+	for _, provider := range providers {
+		if provider.Namespace == nil {
+			continue
+		}
+
+		resourceTypes := make(map[string][]string)
+		if provider.ResourceTypes != nil {
+			for _, resourceType := range *provider.ResourceTypes {
+				if resourceType.ResourceType == nil || resourceType.APIVersions == nil {
+					continue
+				}
+
+				resourceTypes[strings.ToLower(*resourceType.ResourceType)] = *resourceType.APIVersions
+			}
+		}
+
+		output[strings.ToLower(*provider.Namespace)] = resourceTypes
+	}
+
+	return output
+}
+
+// check returns an error if the Resource Provider or the API Version used in the request
+// isn't supported. Requests for Resource Types which aren't listed by the Resource Provider
+// (such as actions) are allowed, since the API can't tell us if they're supported.
+func (versions resourceProviderAPIVersions) check(requestURL *url.URL, environmentName string) error {
+	apiVersion := requestURL.Query().Get("api-version")
+	if apiVersion == "" {
+		return nil
+	}
+
+	segments := strings.Split(strings.Trim(requestURL.Path, "/"), "/")
+	providerIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			providerIndex = i
+		}
+	}
+	if providerIndex == -1 || providerIndex+1 >= len(segments) {
+		return nil
+	}
+
+	namespace := segments[providerIndex+1]
+	resourceTypes, ok := versions[strings.ToLower(namespace)]
+	if !ok {
+		return fmt.Errorf("The Resource Provider %q isn't available in the %q Environment", namespace, environmentName)
+	}
+
+	// the Resource Type is made up of every other segment, e.g. `virtualNetworks/subnets`
+	var typeSegments []string
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		typeSegments = append(typeSegments, segments[i])
+	}
+
+	// check the most specific Resource Type first, since nested Resource Types can be listed separately
+	for i := len(typeSegments); i > 0; i-- {
+		resourceType := strings.Join(typeSegments[:i], "/")
+		supportedVersions, ok := resourceTypes[strings.ToLower(resourceType)]
+		if !ok {
+			continue
+		}
+
+		for _, version := range supportedVersions {
+			if strings.EqualFold(version, apiVersion) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("API Version %q of the Resource Type %q isn't supported in the %q Environment (the supported API Versions are: %s). This resource isn't currently supported in this Environment.",
+			apiVersion, fmt.Sprintf("%s/%s", namespace, resourceType), environmentName, strings.Join(supportedVersions, ", "))
+	}
+
+	return nil
+}
+
+// withAPIVersionCheck returns a SendDecorator which fails requests using an API Version
+// which isn't supported by the Environment (for example, in Azure Stack) rather than
+// sending requests which would fail with an ambiguous error. Requests are only checked
+// once the supported API Versions have been loaded for a custom Environment.
+func withAPIVersionCheck(client *ArmClient) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if client.supportedAPIVersions != nil {
+				if err := client.supportedAPIVersions.check(r.URL, client.environment.Name); err != nil {
+					return nil, err
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
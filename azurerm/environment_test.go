@@ -0,0 +1,339 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+const testAzureStackMetadata = `{
+  "galleryEndpoint": "https://portal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": [
+      "https://management.example.onmicrosoft.com/3b6e4f3b-2b6a-4c4f-9e5a-5d1d4a0b5c1e"
+    ]
+  }
+}`
+
+func TestArmMetadataURL(t *testing.T) {
+	testCases := []struct {
+		input               string
+		expectedMetadataURL string
+		expectedEndpoint    string
+		expectError         bool
+	}{
+		{
+			input:               "https://management.local.azurestack.external",
+			expectedMetadataURL: "https://management.local.azurestack.external/metadata/endpoints?api-version=2015-01-01",
+			expectedEndpoint:    "https://management.local.azurestack.external/",
+		},
+		{
+			input:               "https://management.local.azurestack.external/",
+			expectedMetadataURL: "https://management.local.azurestack.external/metadata/endpoints?api-version=2015-01-01",
+			expectedEndpoint:    "https://management.local.azurestack.external/",
+		},
+		{
+			input:               "https://management.local.azurestack.external/metadata/endpoints?api-version=2017-01-01",
+			expectedMetadataURL: "https://management.local.azurestack.external/metadata/endpoints?api-version=2017-01-01",
+			expectedEndpoint:    "https://management.local.azurestack.external/",
+		},
+		{
+			input:       "management.local.azurestack.external",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		metadataURL, endpoint, err := armMetadataURL(tc.input)
+		if tc.expectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", tc.input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.input, err)
+		}
+		if metadataURL.String() != tc.expectedMetadataURL {
+			t.Fatalf("Expected the Metadata URL for %q to be %q but got %q", tc.input, tc.expectedMetadataURL, metadataURL.String())
+		}
+		if endpoint != tc.expectedEndpoint {
+			t.Fatalf("Expected the Resource Manager Endpoint for %q to be %q but got %q", tc.input, tc.expectedEndpoint, endpoint)
+		}
+	}
+}
+
+func TestLoadEnvironmentFromMetadataURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/endpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, testAzureStackMetadata)
+	}))
+	defer server.Close()
+
+	env, err := loadEnvironmentFromMetadataURL("AzureStack", server.URL)
+	if err != nil {
+		t.Fatalf("Error loading the Environment: %+v", err)
+	}
+
+	if env.Name != "AzureStack" {
+		t.Fatalf("Expected the Name to be `AzureStack` but got %q", env.Name)
+	}
+	if env.ResourceManagerEndpoint != server.URL+"/" {
+		t.Fatalf("Expected the Resource Manager Endpoint to be %q but got %q", server.URL+"/", env.ResourceManagerEndpoint)
+	}
+	if env.ActiveDirectoryEndpoint != "https://login.windows.net/" {
+		t.Fatalf("Unexpected Active Directory Endpoint %q", env.ActiveDirectoryEndpoint)
+	}
+	if env.GraphEndpoint != "https://graph.windows.net/" {
+		t.Fatalf("Unexpected Graph Endpoint %q", env.GraphEndpoint)
+	}
+	if env.ServiceManagementEndpoint != "https://management.example.onmicrosoft.com/3b6e4f3b-2b6a-4c4f-9e5a-5d1d4a0b5c1e" {
+		t.Fatalf("Expected the Token Audience to be used as the Service Management Endpoint but got %q", env.ServiceManagementEndpoint)
+	}
+}
+
+func TestLoadEnvironmentFromMetadataURL_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := loadEnvironmentFromMetadataURL("AzureStack", server.URL); err == nil {
+		t.Fatalf("Expected an error when the Metadata Endpoint returns a 404")
+	}
+}
+
+func TestBuildEnvironmentFromMetadata_AzureStack(t *testing.T) {
+	metadata := armMetadataEndpoints{}
+	metadata.Authentication.LoginEndpoint = "https://adfs.local.azurestack.external/adfs"
+	metadata.Authentication.Audiences = []string{"https://management.adfs.azurestack.local/1234"}
+
+	env, err := buildEnvironmentFromMetadata("AzureStack", "https://management.local.azurestack.external/", metadata)
+	if err != nil {
+		t.Fatalf("Error building the Environment: %+v", err)
+	}
+
+	if env.ActiveDirectoryEndpoint != "https://adfs.local.azurestack.external/" {
+		t.Fatalf("Expected the ADFS tenant to be removed from the Active Directory Endpoint but got %q", env.ActiveDirectoryEndpoint)
+	}
+	if env.StorageEndpointSuffix != "local.azurestack.external" {
+		t.Fatalf("Expected the Storage Endpoint Suffix to be derived from the Resource Manager Endpoint but got %q", env.StorageEndpointSuffix)
+	}
+	if env.KeyVaultDNSSuffix != "vault.local.azurestack.external" {
+		t.Fatalf("Expected the Key Vault DNS Suffix to be derived from the Resource Manager Endpoint but got %q", env.KeyVaultDNSSuffix)
+	}
+	if env.KeyVaultEndpoint != "https://vault.local.azurestack.external/" {
+		t.Fatalf("Unexpected Key Vault Endpoint %q", env.KeyVaultEndpoint)
+	}
+}
+
+func TestBuildEnvironmentFromMetadata_Suffixes(t *testing.T) {
+	metadata := armMetadataEndpoints{
+		Suffixes: &struct {
+			Storage     string `json:"storage"`
+			KeyVaultDNS string `json:"keyVaultDns"`
+		}{
+			Storage:     "core.example.com",
+			KeyVaultDNS: ".vault.example.com",
+		},
+	}
+	metadata.Authentication.LoginEndpoint = "https://login.example.com"
+	metadata.Authentication.Audiences = []string{"https://management.core.example.com/"}
+
+	env, err := buildEnvironmentFromMetadata("Example", "https://management.example.com/", metadata)
+	if err != nil {
+		t.Fatalf("Error building the Environment: %+v", err)
+	}
+
+	if env.StorageEndpointSuffix != "core.example.com" {
+		t.Fatalf("Expected the Storage Endpoint Suffix from the metadata but got %q", env.StorageEndpointSuffix)
+	}
+	if env.KeyVaultDNSSuffix != "vault.example.com" {
+		t.Fatalf("Expected the Key Vault DNS Suffix from the metadata but got %q", env.KeyVaultDNSSuffix)
+	}
+	if env.ActiveDirectoryEndpoint != "https://login.example.com/" {
+		t.Fatalf("Unexpected Active Directory Endpoint %q", env.ActiveDirectoryEndpoint)
+	}
+}
+
+func TestBuildEnvironmentFromMetadata_MissingAudiences(t *testing.T) {
+	metadata := armMetadataEndpoints{}
+	metadata.Authentication.LoginEndpoint = "https://login.windows.net/"
+
+	if _, err := buildEnvironmentFromMetadata("AzureStack", "https://management.local.azurestack.external/", metadata); err == nil {
+		t.Fatalf("Expected an error when no Token Audiences are returned")
+	}
+}
+
+func testResourceProviderAPIVersions() resourceProviderAPIVersions {
+	return newResourceProviderAPIVersions([]resources.Provider{
+		{
+			Namespace: to.StringPtr("Microsoft.Network"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: to.StringPtr("virtualNetworks"),
+					APIVersions:  &[]string{"2015-06-15", "2015-05-01-preview"},
+				},
+				{
+					ResourceType: to.StringPtr("publicIPAddresses"),
+					APIVersions:  &[]string{"2017-03-01", "2015-06-15"},
+				},
+			},
+		},
+		{
+			Namespace: to.StringPtr("Microsoft.Compute"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: to.StringPtr("virtualMachines"),
+					APIVersions:  &[]string{"2016-03-30", "2015-06-15"},
+				},
+			},
+		},
+	})
+}
+
+func TestResourceProviderAPIVersionsCheck(t *testing.T) {
+	versions := testResourceProviderAPIVersions()
+
+	testCases := []struct {
+		url         string
+		expectError bool
+	}{
+		{
+			// unsupported API Version
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1?api-version=2017-03-01",
+			expectError: true,
+		},
+		{
+			// nested Resource Types fall back to the parent Resource Type
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1?api-version=2017-03-01",
+			expectError: true,
+		},
+		{
+			// casing differences are ignored
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.network/publicipaddresses/ip1?api-version=2017-03-01",
+			expectError: false,
+		},
+		{
+			// actions on a supported Resource Type
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/start?api-version=2016-03-30",
+			expectError: false,
+		},
+		{
+			// Resource Types which aren't listed are allowed
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/images/image1?api-version=2016-04-30-preview",
+			expectError: false,
+		},
+		{
+			// unavailable Resource Providers
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1?api-version=2016-10-02",
+			expectError: true,
+		},
+		{
+			// Resource Groups aren't part of a Resource Provider
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2016-09-01",
+			expectError: false,
+		},
+		{
+			// listing the Resource Providers
+			url:         "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/providers?api-version=2016-09-01",
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		requestURL, err := url.Parse(tc.url)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", tc.url, err)
+		}
+
+		err = versions.check(requestURL, "AzureStack")
+		if tc.expectError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.url)
+		}
+		if !tc.expectError && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.url, err)
+		}
+	}
+}
+
+func TestWithAPIVersionCheck(t *testing.T) {
+	requests := 0
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+	})
+
+	client := &ArmClient{
+		environment: azure.Environment{
+			Name: "AzureStack",
+		},
+	}
+	decorated := autorest.DecorateSender(sender, withAPIVersionCheck(client))
+
+	unsupported, _ := http.NewRequest(http.MethodGet, "https://management.local.azurestack.external/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1?api-version=2017-03-01", nil)
+
+	// until the supported API Versions are loaded, requests are sent as-is
+	if _, err := decorated.Do(unsupported); err != nil {
+		t.Fatalf("Expected no error before the API Versions are loaded but got: %+v", err)
+	}
+
+	client.supportedAPIVersions = testResourceProviderAPIVersions()
+	_, err := decorated.Do(unsupported)
+	if err == nil {
+		t.Fatalf("Expected an error for an unsupported API Version")
+	}
+	if !strings.Contains(err.Error(), "Microsoft.Network/virtualNetworks") || !strings.Contains(err.Error(), "2015-06-15") {
+		t.Fatalf("Expected the error to include the Resource Type and the supported API Versions but got: %s", err)
+	}
+
+	if requests != 1 {
+		t.Fatalf("Expected the unsupported request not to be sent, but %d requests were sent", requests)
+	}
+}
+
+func TestConfigGetArmClient_MetadataURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, testAzureStackMetadata)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ClientID:       "11111111-1111-1111-1111-111111111111",
+		ClientSecret:   "secret",
+		TenantID:       "22222222-2222-2222-2222-222222222222",
+		Environment:    "AzureStack",
+		MetadataURL:    server.URL,
+	}
+
+	client, err := config.getArmClient()
+	if err != nil {
+		t.Fatalf("Error building the ARM Client: %+v", err)
+	}
+
+	if client.environment.Name != "AzureStack" {
+		t.Fatalf("Expected the custom Environment to be used but got %q", client.environment.Name)
+	}
+	if client.vnetClient().BaseURI != server.URL+"/" {
+		t.Fatalf("Expected the clients to use the Resource Manager Endpoint %q but got %q", server.URL+"/", client.vnetClient().BaseURI)
+	}
+}
@@ -0,0 +1,721 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// fakeArmSubscriptionID is the Subscription ID served by the fake ARM server
+const fakeArmSubscriptionID = "00000000-0000-0000-0000-000000000000"
+
+// fakeArmServer is an in-process implementation of Azure Resource Manager which stores resources in
+// memory, such that the Create/Read/Update/Delete (and Import) logic of resources can be tested in
+// `go test` without credentials.
+//
+// Resources are stored as the JSON sent in the PUT, alongside the `id`, `name`, `type` and the
+// `provisioningState` which ARM populates. Resources are created synchronously, unless their type
+// has been registered as long-running - in which case a 201/202 is returned with the polling
+// headers, and the operation completes after it's been polled `pollsUntilComplete` times.
+type fakeArmServer struct {
+	*httptest.Server
+
+	lock       sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]*fakeArmOperation
+	requests   []string
+
+	// longRunningMethods are the HTTP Methods which are long-running operations, keyed by the (lower-cased)
+	// Resource Type - since this differs between services (e.g. Storage Accounts are deleted synchronously)
+	longRunningMethods map[string][]string
+
+	// pollsUntilComplete is the number of times a long-running operation is polled before completing
+	pollsUntilComplete int
+
+	// actions are the handlers for POST requests against a resource (e.g. `listKeys`), keyed by the
+	// (lower-cased) Resource Type and the name of the action
+	actions map[string]fakeArmAction
+
+	// subscriptions are the Subscription ID's served, in addition to `fakeArmSubscriptionID`
+	subscriptions map[string]struct{}
+}
+
+// fakeArmAction handles a POST request against an existing resource, returning the response body.
+type fakeArmAction func(resource map[string]interface{}) interface{}
+
+type fakeArmOperation struct {
+	key              string
+	method           string
+	pollsRemaining   int
+	asyncOperationID bool
+}
+
+func newFakeArmServer() *fakeArmServer {
+	s := &fakeArmServer{
+		resources:          make(map[string]map[string]interface{}),
+		operations:         make(map[string]*fakeArmOperation),
+		longRunningMethods: make(map[string][]string),
+		pollsUntilComplete: 1,
+		actions:            make(map[string]fakeArmAction),
+		subscriptions:      map[string]struct{}{fakeArmSubscriptionID: {}},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// longRunning registers the PUT and/or DELETE requests for the Resource Type as long-running operations.
+func (s *fakeArmServer) longRunning(resourceType string, methods ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.longRunningMethods[strings.ToLower(resourceType)] = methods
+}
+
+// handleAction registers the handler for POST requests to `{id}/{action}` for the Resource Type.
+func (s *fakeArmServer) handleAction(resourceType string, action string, handler fakeArmAction) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.actions[strings.ToLower(resourceType+"/"+action)] = handler
+}
+
+// addSubscription serves an additional Subscription, e.g. for resources which override the `subscription_id`.
+func (s *fakeArmServer) addSubscription(subscriptionID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.subscriptions[subscriptionID] = struct{}{}
+}
+
+// completeOperations completes the long-running operations which are in progress, e.g. to simulate an
+// operation which continues in Azure after Terraform's stopped waiting for it.
+func (s *fakeArmServer) completeOperations() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, operation := range s.operations {
+		if operation.pollsRemaining > 0 {
+			s.completeOperation(operation)
+		}
+	}
+}
+
+// get returns the resource with the specified ID, if it exists.
+func (s *fakeArmServer) get(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	return resource, ok
+}
+
+// put stores the resource with the specified ID, e.g. to simulate a change made outside of Terraform.
+func (s *fakeArmServer) put(id string, resource map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[strings.ToLower(id)] = resource
+}
+
+// requestsFor returns the number of requests made with the HTTP Method against the specified ID.
+func (s *fakeArmServer) requestsFor(method string, id string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	count := 0
+	for _, r := range s.requests {
+		if strings.EqualFold(r, method+" "+id) {
+			count++
+		}
+	}
+	return count
+}
+
+// changesFor returns the requests which change the resource with the specified ID (or invoke one of its
+// actions), in the order they were made - e.g. `POST {id}/deallocate`.
+func (s *fakeArmServer) changesFor(id string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	changes := make([]string, 0)
+	for _, r := range s.requests {
+		method := strings.SplitN(r, " ", 2)[0]
+		if method == http.MethodGet || method == http.MethodHead {
+			continue
+		}
+
+		path := strings.ToLower(strings.TrimPrefix(r, method+" "))
+		if path == strings.ToLower(id) || strings.HasPrefix(path, strings.ToLower(id+"/")) {
+			changes = append(changes, r)
+		}
+	}
+	return changes
+}
+
+// providers returns the Providers used to run Terraform configurations against the fake server.
+func (s *fakeArmServer) providers() map[string]terraform.ResourceProvider {
+	p := Provider().(*schema.Provider)
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config := &Config{
+			SubscriptionID:          fakeArmSubscriptionID,
+			Environment:             d.Get("environment").(string),
+			DefaultTags:             d.Get("default_tags").(map[string]interface{}),
+			resourceManagerEndpoint: s.URL,
+		}
+
+		client, err := config.getArmClient()
+		if err != nil {
+			return nil, err
+		}
+		client.StopContext = p.StopContext()
+
+		return client, nil
+	}
+
+	return map[string]terraform.ResourceProvider{
+		"azurerm": p,
+	}
+}
+
+// checkExists returns a TestCheckFunc which ensures the resource in the state exists in the fake server.
+func (s *fakeArmServer) checkExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, ok := s.get(rs.Primary.ID); !ok {
+			return fmt.Errorf("Bad: %q (ID %q) does not exist in the fake ARM server", name, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// checkDisappears returns a TestCheckFunc which removes the resource in the state from the fake server,
+// as if it was deleted outside of Terraform.
+func (s *fakeArmServer) checkDisappears(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.remove(strings.ToLower(rs.Primary.ID))
+
+		return nil
+	}
+}
+
+// checkDestroy returns a CheckDestroy function which ensures every resource of the specified type in
+// the state has been removed from the fake server.
+func (s *fakeArmServer) checkDestroy(resourceType string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if _, ok := s.get(rs.Primary.ID); ok {
+				return fmt.Errorf("Bad: %q still exists in the fake ARM server", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// checkImported returns an ImportStateCheckFunc which ensures exactly the resources with the specified ID's
+// were imported, e.g. to check the child resources which are imported alongside their parent.
+func (s *fakeArmServer) checkImported(ids ...string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		expected := make([]string, 0, len(ids))
+		for _, id := range ids {
+			expected = append(expected, strings.ToLower(id))
+		}
+		sort.Strings(expected)
+
+		actual := make([]string, 0, len(states))
+		for _, state := range states {
+			actual = append(actual, strings.ToLower(state.ID))
+		}
+		sort.Strings(actual)
+
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("Expected the imported resources to be %+v but got %+v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func (s *fakeArmServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	s.requests = append(s.requests, r.Method+" "+path)
+
+	if strings.HasPrefix(path, "/operations/") {
+		s.pollOperation(w, strings.TrimPrefix(path, "/operations/"))
+		return
+	}
+
+	if r.URL.Query().Get("api-version") == "" {
+		writeFakeArmError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		writeFakeArmError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The path %q isn't supported by the fake ARM server.", path))
+		return
+	}
+	if _, ok := s.subscriptions[segments[1]]; !ok {
+		writeFakeArmError(w, http.StatusNotFound, "SubscriptionNotFound", fmt.Sprintf("The subscription %q could not be found.", segments[1]))
+		return
+	}
+
+	isResource, supported := fakeArmPathKind(segments)
+	if !supported {
+		writeFakeArmError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The path %q isn't supported by the fake ARM server.", path))
+		return
+	}
+
+	if !isResource {
+		if r.Method == http.MethodPost {
+			s.invokeAction(w, segments)
+			return
+		}
+
+		s.list(w, segments)
+		return
+	}
+
+	id := fakeArmResourceID(segments)
+	key := strings.ToLower(id)
+
+	switch r.Method {
+	case http.MethodHead:
+		if _, ok := s.resources[key]; ok {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+	case http.MethodGet:
+		resource, ok := s.resources[key]
+		if !ok {
+			writeFakeArmError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+			return
+		}
+		writeFakeArmJSON(w, http.StatusOK, resource)
+
+	case http.MethodPut, http.MethodPatch:
+		s.createOrUpdate(w, r, segments, id)
+
+	case http.MethodDelete:
+		s.delete(w, segments, id)
+
+	default:
+		writeFakeArmError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %q isn't supported.", r.Method))
+	}
+}
+
+// fakeArmPowerStateAction returns a handler for an action (e.g. `deallocate`) which changes the power state
+// reported in the instance view of the Virtual Machine.
+func fakeArmPowerStateAction(powerState string) fakeArmAction {
+	return func(vm map[string]interface{}) interface{} {
+		properties := vm["properties"].(map[string]interface{})
+		properties["instanceView"] = map[string]interface{}{
+			"statuses": []interface{}{
+				map[string]interface{}{
+					"code": "ProvisioningState/succeeded",
+				},
+				map[string]interface{}{
+					"code": "PowerState/" + powerState,
+				},
+			},
+		}
+
+		return map[string]interface{}{
+			"status": "Succeeded",
+		}
+	}
+}
+
+func (s *fakeArmServer) createOrUpdate(w http.ResponseWriter, r *http.Request, segments []string, id string) {
+	key := strings.ToLower(id)
+
+	if err := s.checkParentsExist(segments); err != nil {
+		writeFakeArmError(w, http.StatusNotFound, err.code, err.message)
+		return
+	}
+
+	if s.operationInProgress(key) {
+		writeFakeArmError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("Another operation on %q is in progress.", id))
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeFakeArmError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error reading the request content: %+v", err))
+		return
+	}
+
+	resource := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &resource); err != nil {
+			writeFakeArmError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid: %+v", err))
+			return
+		}
+	}
+
+	existing, exists := s.resources[key]
+	if r.Method == http.MethodPatch {
+		if !exists {
+			writeFakeArmError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+			return
+		}
+		resource = mergeFakeArmResource(existing, resource)
+	}
+
+	resource["id"] = id
+	resource["name"] = segments[len(segments)-1]
+	resource["type"] = fakeArmResourceType(segments)
+
+	properties, _ := resource["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = make(map[string]interface{})
+		resource["properties"] = properties
+	}
+	properties["provisioningState"] = "Succeeded"
+
+	// ARM assigns the principal of a system-assigned identity, and removes the identity once it's disabled
+	if identity, ok := resource["identity"].(map[string]interface{}); ok {
+		if strings.EqualFold(fmt.Sprintf("%v", identity["type"]), "None") {
+			delete(resource, "identity")
+		} else {
+			identity["principalId"] = fmt.Sprintf("00000000-0000-0000-0000-%012x", hashcode.String(key))
+			identity["tenantId"] = "00000000-0000-0000-0000-000000000000"
+		}
+	}
+
+	// the instance view (e.g. the power state of a Virtual Machine) is maintained by ARM rather than sent in the PUT
+	if exists {
+		if existingProperties, ok := existing["properties"].(map[string]interface{}); ok {
+			if instanceView, ok := existingProperties["instanceView"]; ok {
+				properties["instanceView"] = instanceView
+			}
+		}
+	}
+
+	if !s.isLongRunning(segments, r.Method) {
+		s.resources[key] = resource
+		writeFakeArmJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	if exists {
+		properties["provisioningState"] = "Updating"
+	} else {
+		properties["provisioningState"] = "Creating"
+	}
+	s.resources[key] = resource
+
+	operationID := s.startOperation(key, http.MethodPut, true)
+	w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s/operations/%s", s.URL, operationID))
+	w.Header().Set("Retry-After", "0")
+	writeFakeArmJSON(w, http.StatusCreated, resource)
+}
+
+func (s *fakeArmServer) delete(w http.ResponseWriter, segments []string, id string) {
+	key := strings.ToLower(id)
+
+	resource, exists := s.resources[key]
+	if !exists {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if s.operationInProgress(key) {
+		writeFakeArmError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("Another operation on %q is in progress.", id))
+		return
+	}
+
+	if !s.isLongRunning(segments, http.MethodDelete) {
+		s.remove(key)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if properties, ok := resource["properties"].(map[string]interface{}); ok {
+		properties["provisioningState"] = "Deleting"
+	}
+
+	operationID := s.startOperation(key, http.MethodDelete, false)
+	w.Header().Set("Location", fmt.Sprintf("%s/operations/%s", s.URL, operationID))
+	w.Header().Set("Retry-After", "0")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// remove deletes the resource and any resources nested within it (e.g. those in a Resource Group)
+func (s *fakeArmServer) remove(key string) {
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func (s *fakeArmServer) list(w http.ResponseWriter, segments []string) {
+	prefix := strings.ToLower(fakeArmResourceID(segments)) + "/"
+	depth := 0
+
+	// the Record Sets of every type within a DNS Zone are listed from `{zone}/recordsets`
+	if strings.EqualFold(segments[len(segments)-1], "recordsets") {
+		prefix = strings.ToLower(fakeArmResourceID(segments[:len(segments)-1])) + "/"
+		depth = 1
+	}
+
+	keys := make([]string, 0)
+	for key := range s.resources {
+		if strings.HasPrefix(key, prefix) && strings.Count(strings.TrimPrefix(key, prefix), "/") == depth {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, s.resources[key])
+	}
+
+	writeFakeArmJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *fakeArmServer) invokeAction(w http.ResponseWriter, segments []string) {
+	resourceSegments := segments[:len(segments)-1]
+	id := fakeArmResourceID(resourceSegments)
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		writeFakeArmError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+		return
+	}
+
+	action := strings.ToLower(fakeArmResourceType(resourceSegments) + "/" + segments[len(segments)-1])
+	handler, ok := s.actions[action]
+	if !ok {
+		writeFakeArmError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The action %q isn't supported by the fake ARM server.", action))
+		return
+	}
+
+	writeFakeArmJSON(w, http.StatusOK, handler(resource))
+}
+
+func (s *fakeArmServer) startOperation(key string, method string, asyncOperationID bool) string {
+	operationID := fmt.Sprintf("%d", len(s.operations)+1)
+	s.operations[operationID] = &fakeArmOperation{
+		key:              key,
+		method:           method,
+		pollsRemaining:   s.pollsUntilComplete,
+		asyncOperationID: asyncOperationID,
+	}
+	return operationID
+}
+
+func (s *fakeArmServer) pollOperation(w http.ResponseWriter, operationID string) {
+	operation, ok := s.operations[operationID]
+	if !ok {
+		writeFakeArmError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation %q was not found.", operationID))
+		return
+	}
+
+	if operation.pollsRemaining > 0 {
+		operation.pollsRemaining--
+		// the delay between polling requests is controlled by the Retry-After header
+		w.Header().Set("Retry-After", "0")
+		if operation.asyncOperationID {
+			writeFakeArmJSON(w, http.StatusOK, map[string]interface{}{"status": "InProgress"})
+		} else {
+			w.Header().Set("Location", fmt.Sprintf("%s/operations/%s", s.URL, operationID))
+			w.WriteHeader(http.StatusAccepted)
+		}
+		return
+	}
+
+	s.completeOperation(operation)
+
+	if operation.asyncOperationID {
+		writeFakeArmJSON(w, http.StatusOK, map[string]interface{}{"status": "Succeeded"})
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *fakeArmServer) completeOperation(operation *fakeArmOperation) {
+	operation.pollsRemaining = 0
+
+	if operation.method == http.MethodDelete {
+		s.remove(operation.key)
+	} else if resource, ok := s.resources[operation.key]; ok {
+		if properties, ok := resource["properties"].(map[string]interface{}); ok {
+			properties["provisioningState"] = "Succeeded"
+		}
+	}
+}
+
+func (s *fakeArmServer) operationInProgress(key string) bool {
+	for _, operation := range s.operations {
+		if operation.key == key && operation.pollsRemaining > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *fakeArmServer) isLongRunning(segments []string, method string) bool {
+	for _, m := range s.longRunningMethods[strings.ToLower(fakeArmResourceType(segments))] {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeArmError struct {
+	code    string
+	message string
+}
+
+// checkParentsExist ensures the Resource Group (and for nested resources, the parent resource) exists
+func (s *fakeArmServer) checkParentsExist(segments []string) *fakeArmError {
+	if len(segments) == 4 {
+		return nil
+	}
+
+	resourceGroupID := fakeArmResourceID(segments[:4])
+	if _, ok := s.resources[strings.ToLower(resourceGroupID)]; !ok {
+		return &fakeArmError{"ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3])}
+	}
+
+	if len(segments) > 8 {
+		parentID := fakeArmResourceID(segments[:len(segments)-2])
+		if _, ok := s.resources[strings.ToLower(parentID)]; !ok {
+			return &fakeArmError{"ParentResourceNotFound", fmt.Sprintf("The parent resource %q was not found.", parentID)}
+		}
+	}
+
+	return nil
+}
+
+// fakeArmPathKind returns whether the path is a resource - or otherwise a collection (GET) or an action
+// on a resource (POST). Everything below a Resource Group is `{namespace}` followed by `{type}/{name}`
+// pairs for a resource, so a collection or action has an odd number of segments.
+func fakeArmPathKind(segments []string) (isResource bool, supported bool) {
+	if len(segments) < 3 || !strings.EqualFold(segments[2], "resourceGroups") {
+		return false, false
+	}
+
+	switch {
+	case len(segments) == 3:
+		return false, true
+	case len(segments) == 4:
+		return true, true
+	case len(segments) >= 7 && strings.EqualFold(segments[4], "providers"):
+		return len(segments)%2 == 0, true
+	}
+
+	return false, false
+}
+
+// fakeArmResourceID returns the Resource ID for the path, using the casing ARM returns for the
+// subscription & resource group segments.
+func fakeArmResourceID(segments []string) string {
+	normalized := make([]string, len(segments))
+	copy(normalized, segments)
+
+	normalized[0] = "subscriptions"
+	if len(normalized) > 2 {
+		normalized[2] = "resourceGroups"
+	}
+	if len(normalized) > 4 {
+		normalized[4] = "providers"
+	}
+
+	return "/" + strings.Join(normalized, "/")
+}
+
+// fakeArmResourceType returns the Resource Type for the path, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func fakeArmResourceType(segments []string) string {
+	if len(segments) <= 4 {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	types := []string{segments[5]}
+	for i := 6; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// mergeFakeArmResource applies the PATCH to the existing resource, merging the top-level fields and the properties.
+func mergeFakeArmResource(existing map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(existing))
+	for k, v := range existing {
+		merged[k] = v
+	}
+
+	for k, v := range patch {
+		if k == "properties" {
+			properties := make(map[string]interface{})
+			if existingProperties, ok := existing["properties"].(map[string]interface{}); ok {
+				for pk, pv := range existingProperties {
+					properties[pk] = pv
+				}
+			}
+			if patchProperties, ok := v.(map[string]interface{}); ok {
+				for pk, pv := range patchProperties {
+					properties[pk] = pv
+				}
+			}
+			merged[k] = properties
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	return merged
+}
+
+func writeFakeArmJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeArmError(w http.ResponseWriter, statusCode int, code string, message string) {
+	writeFakeArmJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
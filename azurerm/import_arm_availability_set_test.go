@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAvailabilitySet_importBasic(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMAvailabilitySet_importBasic(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_importWithTags(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_withTags(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMAvailabilitySet_importWithTags(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_importWithDomainCounts(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_withDomainCounts(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccAzureRMAvailabilitySet_importWithDomainCounts(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_importManaged(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_managed(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMCdnEndpoint_importWithTags(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnEndpoint_withTags(ri)
 
 	resource.Test(t, resource.TestCase{
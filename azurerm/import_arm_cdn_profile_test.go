@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMCdnProfile_importWithTags(t *testing.T) {
 	resourceName := "azurerm_cdn_profile.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnProfile_withTags(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -3,15 +3,14 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMContainerRegistry_importBasic(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_basic(ri, rs)
 
 	resource.Test(t, resource.TestCase{
@@ -36,8 +35,8 @@ func TestAccAzureRMContainerRegistry_importBasic(t *testing.T) {
 func TestAccAzureRMContainerRegistry_importComplete(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_complete(ri, rs)
 
 	resource.Test(t, resource.TestCase{
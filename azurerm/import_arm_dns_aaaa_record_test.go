@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsAAAARecord_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_aaaa_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsAAAARecord_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMDnsAAAARecord_importBasic(t *testing.T) {
 func TestAccAzureRMDnsAAAARecord_importWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_aaaa_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsAAAARecord_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsMxRecord_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_mx_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsMxRecord_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMDnsMxRecord_importBasic(t *testing.T) {
 func TestAccAzureRMDnsMxRecord_importWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_mx_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsMxRecord_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsSrvRecord_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_srv_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsSrvRecord_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMDnsSrvRecord_importBasic(t *testing.T) {
 func TestAccAzureRMDnsSrvRecord_importWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_srv_record.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsSrvRecord_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
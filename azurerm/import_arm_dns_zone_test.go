@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsZone_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_zone.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsZone_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMDnsZone_importBasic(t *testing.T) {
 func TestAccAzureRMDnsZone_importBasicWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_zone.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsZone_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMEventHubAuthorizationRule_importListen(t *testing.T) {
 	resourceName := "azurerm_eventhub_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_listen(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMEventHubAuthorizationRule_importListen(t *testing.T) {
 func TestAccAzureRMEventHubAuthorizationRule_importSend(t *testing.T) {
 	resourceName := "azurerm_eventhub_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_send(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMEventHubAuthorizationRule_importSend(t *testing.T) {
 func TestAccAzureRMEventHubAuthorizationRule_importReadWrite(t *testing.T) {
 	resourceName := "azurerm_eventhub_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_readWrite(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccAzureRMEventHubAuthorizationRule_importReadWrite(t *testing.T) {
 func TestAccAzureRMEventHubAuthorizationRule_importManage(t *testing.T) {
 	resourceName := "azurerm_eventhub_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_manage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMEventHubNamespace_importBasic(t *testing.T) {
 	resourceName := "azurerm_eventhub_namespace.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubNamespace_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMLoadBalancerNatRule_importBasic(t *testing.T) {
 	resourceName := "azurerm_lb_nat_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	natRuleName := fmt.Sprintf("NatRule-%d", ri)
 
 	resource.Test(t, resource.TestCase{
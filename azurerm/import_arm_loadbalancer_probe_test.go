@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMLoadBalancerProbe_importBasic(t *testing.T) {
 	resourceName := "azurerm_lb_probe.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	probeName := fmt.Sprintf("probe-%d", ri)
 
 	resource.Test(t, resource.TestCase{
@@ -3,13 +3,12 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMNetworkInterface_importBasic(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -30,7 +29,7 @@ func TestAccAzureRMNetworkInterface_importBasic(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_importIPForwarding(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -51,7 +50,7 @@ func TestAccAzureRMNetworkInterface_importIPForwarding(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_importWithTags(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -72,7 +71,7 @@ func TestAccAzureRMNetworkInterface_importWithTags(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_importMultipleLoadBalancers(t *testing.T) {
 	resourceName := "azurerm_network_interface.test1"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -93,7 +92,7 @@ func TestAccAzureRMNetworkInterface_importMultipleLoadBalancers(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_importPublicIP(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
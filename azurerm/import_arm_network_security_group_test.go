@@ -1,15 +1,17 @@
 package azurerm
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMNetworkSecurityGroup_importBasic(t *testing.T) {
 	resourceName := "azurerm_network_security_group.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -27,3 +29,38 @@ func TestAccAzureRMNetworkSecurityGroup_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAzureRMNetworkSecurityGroup_importSecurityRules(t *testing.T) {
+	resourceName := "azurerm_network_security_group.test"
+	rInt := acctRandInt(t)
+	importId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/networkSecurityGroups/acceptanceTestSecurityGroup1/securityRules", os.Getenv("ARM_SUBSCRIPTION_ID"), rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityRule_basic(rInt, testLocation()),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           importId,
+				ImportStateCheck:        testCheckAzureRMNetworkSecurityGroupImported(1),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"network_security_group_name"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkSecurityGroupImported(securityRules int) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != securityRules+1 {
+			return fmt.Errorf("Expected the Network Security Group and %d Security Rules to be imported but got %d resources", securityRules, len(states))
+		}
+
+		return nil
+	}
+}
@@ -3,12 +3,11 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMNetworkSecurityRule_importBasic(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resourceName := "azurerm_network_security_rule.test"
 
 	resource.Test(t, resource.TestCase{
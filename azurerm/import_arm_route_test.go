@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMRoute_importBasic(t *testing.T) {
 	resourceName := "azurerm_route.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRoute_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
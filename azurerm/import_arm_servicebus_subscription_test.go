@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMServiceBusSubscription_importBasic(t *testing.T) {
 	resourceName := "azurerm_servicebus_subscription.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMServiceBusSubscription_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
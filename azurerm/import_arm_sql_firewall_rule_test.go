@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMSqlFirewallRule_importBasic(t *testing.T) {
 	resourceName := "azurerm_sql_firewall_rule.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSqlFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
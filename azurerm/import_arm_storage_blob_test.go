@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStorageBlob_importBasic(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+
+	ri := acctRandInt(t)
+	rs := strings.ToLower(acctRandString(t, 11))
+	config := testAccAzureRMStorageBlob_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStorageQueue_importBasic(t *testing.T) {
+	resourceName := "azurerm_storage_queue.test"
+
+	ri := acctRandInt(t)
+	rs := strings.ToLower(acctRandString(t, 11))
+	config := testAccAzureRMStorageQueue_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
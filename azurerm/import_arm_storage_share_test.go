@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStorageShare_importBasic(t *testing.T) {
+	resourceName := "azurerm_storage_share.test"
+
+	ri := acctRandInt(t)
+	rs := strings.ToLower(acctRandString(t, 11))
+	config := testAccAzureRMStorageShare_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"quota"},
+			},
+		},
+	})
+}
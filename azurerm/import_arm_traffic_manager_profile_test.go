@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMTrafficManagerProfile_importBasic(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_performance(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMVirtualNetwork_importBasic(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualNetwork_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
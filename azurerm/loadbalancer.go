@@ -3,7 +3,6 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
-	"regexp"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
@@ -13,18 +12,18 @@ import (
 )
 
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
-	id, err := parseAzureResourceID(loadBalancerId)
+	id, err := parseLoadBalancerID(loadBalancerId)
 	if err != nil {
 		return "", "", err
 	}
-	name := id.Path["loadBalancers"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	return resGroup, name, nil
 }
 
 func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
-	loadBalancerClient := meta.(*ArmClient).loadBalancerClient
+	loadBalancerClient := meta.(*ArmClient).loadBalancerClient()
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
@@ -128,7 +127,7 @@ func findLoadBalancerProbeByName(lb *network.LoadBalancer, name string) (*networ
 
 func loadbalancerStateRefreshFunc(client *ArmClient, resourceGroupName string, loadbalancer string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.loadBalancerClient.Get(resourceGroupName, loadbalancer, "")
+		res, err := client.loadBalancerClient().Get(resourceGroupName, loadbalancer, "")
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in loadbalancerStateRefreshFunc to Azure ARM for LoadBalancer '%s' (RG: '%s'): %s", loadbalancer, resourceGroupName, err)
 		}
@@ -147,21 +146,21 @@ func validateLoadBalancerPrivateIpAddressAllocation(v interface{}, k string) (ws
 
 // sets the loadbalancer_id in the ResourceData from the sub resources full id
 func loadBalancerSubResourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	r, err := regexp.Compile(`.+\/loadBalancers\/.+?\/`)
-	if err != nil {
-		return nil, err
-	}
-
-	lbID := strings.TrimSuffix(r.FindString(d.Id()), "/")
-	parsed, err := parseAzureResourceID(lbID)
+	id, err := splitAzureResourceID(d.Id())
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse loadbalancer id from %s", d.Id())
 	}
 
-	if parsed.Path["loadBalancers"] == "" {
+	if len(id.Segments) != 2 || !strings.EqualFold(id.Segments[0].Key, "loadBalancers") {
 		return nil, fmt.Errorf("parsed ID is invalid")
 	}
 
+	lbID := LoadBalancerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}.String()
+
 	d.Set("loadbalancer_id", lbID)
 	return []*schema.ResourceData{d}, nil
 }
@@ -22,3 +22,14 @@ func azureRMUnlockMultipleByName(names *[]string, resourceType string) {
 		azureRMUnlockByName(name, resourceType)
 	}
 }
+
+// sliceContainsValue returns whether the value is within the slice, e.g. to avoid locking the same name
+// twice with azureRMLockMultipleByName - which would deadlock.
+func sliceContainsValue(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+const (
+	// defaultMSIEndpoint is the Managed Service Identity endpoint exposed by the
+	// Azure Instance Metadata Service, which is available on all Azure VM's
+	defaultMSIEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+	msiAPIVersion = "2018-02-01"
+
+	// tokens are refreshed when they're due to expire within this window
+	msiTokenRefreshWithin = 5 * time.Minute
+)
+
+// msiToken is an OAuth Token Provider which obtains (and refreshes) tokens for the
+// Managed Service Identity of the Azure VM which Terraform is running on.
+// Unlike a Service Principal there's no Refresh Token - instead a new Access Token
+// is requested from the MSI endpoint when the current token is due to expire.
+type msiToken struct {
+	endpoint string
+	resource string
+	clientID string
+	sender   *http.Client
+
+	lock  sync.Mutex
+	token adal.Token
+}
+
+func newMSIToken(endpoint string, resource string, clientID string) *msiToken {
+	if endpoint == "" {
+		endpoint = defaultMSIEndpoint
+	}
+
+	return &msiToken{
+		endpoint: endpoint,
+		resource: resource,
+		clientID: clientID,
+		sender: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// OAuthToken returns the current Access Token.
+func (t *msiToken) OAuthToken() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.token.AccessToken
+}
+
+// EnsureFresh obtains a new Access Token if the current token is due to expire.
+func (t *msiToken) EnsureFresh() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.token.WillExpireIn(msiTokenRefreshWithin) {
+		return nil
+	}
+
+	return t.refreshInternal(t.resource)
+}
+
+// Refresh obtains a new Access Token from the MSI endpoint.
+func (t *msiToken) Refresh() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.refreshInternal(t.resource)
+}
+
+// RefreshExchange obtains a new Access Token for a different resource from the MSI endpoint.
+func (t *msiToken) RefreshExchange(resource string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.refreshInternal(resource)
+}
+
+func (t *msiToken) refreshInternal(resource string) error {
+	endpoint, err := url.Parse(t.endpoint)
+	if err != nil {
+		return fmt.Errorf("Error parsing the MSI Endpoint %q: %+v", t.endpoint, err)
+	}
+
+	query := endpoint.Query()
+	query.Set("api-version", msiAPIVersion)
+	query.Set("resource", resource)
+	if t.clientID != "" {
+		query.Set("client_id", t.clientID)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return fmt.Errorf("Error building the MSI token request: %+v", err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	log.Printf("[DEBUG] Requesting a Managed Service Identity token for %q from %q", resource, t.endpoint)
+	resp, err := t.sender.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error requesting a token from the MSI Endpoint %q: %+v", t.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Error reading the token returned from the MSI Endpoint %q: %+v", t.endpoint, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error requesting a token from the MSI Endpoint %q: Status Code %d: %s", t.endpoint, resp.StatusCode, string(body))
+	}
+
+	var token adal.Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return fmt.Errorf("Error parsing the token returned from the MSI Endpoint %q: %+v", t.endpoint, err)
+	}
+
+	if token.AccessToken == "" {
+		return fmt.Errorf("The MSI Endpoint %q returned an empty Access Token", t.endpoint)
+	}
+
+	t.token = token
+	return nil
+}
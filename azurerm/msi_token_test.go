@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// testMSIServer returns a stand-in for the Instance Metadata Service which issues
+// tokens expiring after the specified duration, and counts the requests made to it.
+func testMSIServer(t *testing.T, expiresIn time.Duration, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(requests, 1)
+
+		if r.Method != http.MethodGet {
+			t.Fatalf("Expected a GET request but got %q", r.Method)
+		}
+		if r.Header.Get("Metadata") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"invalid_request","error_description":"Required metadata header not specified"}`)
+			return
+		}
+
+		query := r.URL.Query()
+		if query.Get("api-version") == "" {
+			t.Fatalf("Expected an `api-version` to be specified")
+		}
+
+		resource := query.Get("resource")
+		expiresOn := time.Now().Add(expiresIn).Unix()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d-%s","client_id":%q,"expires_in":"%d","expires_on":"%d","not_before":"%d","resource":%q,"token_type":"Bearer"}`,
+			count, resource, query.Get("client_id"), int(expiresIn.Seconds()), expiresOn, time.Now().Unix(), resource)
+	}))
+}
+
+func TestMSIToken_EnsureFresh(t *testing.T) {
+	var requests int32
+	server := testMSIServer(t, time.Hour, &requests)
+	defer server.Close()
+
+	token := newMSIToken(server.URL, "https://management.azure.com/", "")
+	if err := token.EnsureFresh(); err != nil {
+		t.Fatalf("Error obtaining an MSI token: %+v", err)
+	}
+	if token.OAuthToken() != "token-1-https://management.azure.com/" {
+		t.Fatalf("Unexpected Access Token %q", token.OAuthToken())
+	}
+
+	// the token is valid for an hour, so shouldn't be requested again
+	if err := token.EnsureFresh(); err != nil {
+		t.Fatalf("Error ensuring the MSI token is fresh: %+v", err)
+	}
+	if count := atomic.LoadInt32(&requests); count != 1 {
+		t.Fatalf("Expected 1 request to the MSI Endpoint but got %d", count)
+	}
+}
+
+func TestMSIToken_RefreshesExpiringTokens(t *testing.T) {
+	var requests int32
+	server := testMSIServer(t, time.Minute, &requests)
+	defer server.Close()
+
+	token := newMSIToken(server.URL, "https://graph.windows.net/", "")
+	if err := token.EnsureFresh(); err != nil {
+		t.Fatalf("Error obtaining an MSI token: %+v", err)
+	}
+
+	// the token expires within the refresh window, so a new one should be requested
+	if err := token.EnsureFresh(); err != nil {
+		t.Fatalf("Error refreshing the MSI token: %+v", err)
+	}
+	if token.OAuthToken() != "token-2-https://graph.windows.net/" {
+		t.Fatalf("Expected the refreshed Access Token but got %q", token.OAuthToken())
+	}
+}
+
+func TestMSIToken_UserAssignedIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientID := r.URL.Query().Get("client_id"); clientID != "11111111-1111-1111-1111-111111111111" {
+			t.Fatalf("Expected the Client ID to be sent but got %q", clientID)
+		}
+
+		fmt.Fprintf(w, `{"access_token":"user-assigned","expires_on":"%d","token_type":"Bearer"}`, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	token := newMSIToken(server.URL, "https://management.azure.com/", "11111111-1111-1111-1111-111111111111")
+	if err := token.Refresh(); err != nil {
+		t.Fatalf("Error obtaining an MSI token: %+v", err)
+	}
+}
+
+func TestMSIToken_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid_resource","error_description":"AADSTS50001: The application was not found"}`)
+	}))
+	defer server.Close()
+
+	token := newMSIToken(server.URL, "https://management.azure.com/", "")
+	if err := token.EnsureFresh(); err == nil {
+		t.Fatalf("Expected an error when the MSI Endpoint returns a 400")
+	}
+}
+
+func TestConfigGetAuthorizer_MSI(t *testing.T) {
+	var requests int32
+	server := testMSIServer(t, time.Hour, &requests)
+	defer server.Close()
+
+	config := Config{
+		UseMsi:      true,
+		MsiEndpoint: server.URL,
+	}
+	authorizer, err := config.getAuthorizer(adal.OAuthConfig{}, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("Error building the MSI Authorizer: %+v", err)
+	}
+
+	req, err := autorest.Prepare(&http.Request{}, autorest.WithBaseURL("https://management.azure.com/"), authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing the request: %+v", err)
+	}
+
+	expected := "Bearer token-1-https://management.azure.com/"
+	if header := req.Header.Get("Authorization"); header != expected {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", expected, header)
+	}
+}
+
+func TestMSIToken_ImplementsRefresher(t *testing.T) {
+	var _ adal.Refresher = newMSIToken("", "", "")
+	var _ adal.OAuthTokenProvider = newMSIToken("", "", "")
+
+	if token := newMSIToken("", "", ""); token.endpoint != defaultMSIEndpoint {
+		t.Fatalf("Expected the default MSI Endpoint but got %q", token.endpoint)
+	}
+}
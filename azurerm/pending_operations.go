@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Long-running operations in ARM return a 201/202 alongside an `Azure-AsyncOperation` or
+// `Location` header, which is polled until the operation completes. When Terraform's
+// interrupted (or times out) whilst waiting, the operation continues in Azure - and re-issuing
+// the request on the next run typically fails with a Conflict until it completes.
+//
+// To avoid this, the resource is stored in the state as soon as the request's been accepted, and
+// Create/Update wait for any operation which is still in progress before issuing a new request.
+// The version of Terraform which is vendored doesn't expose Private state to Providers - so rather
+// than storing the polling URL, the `provisioningState` of the resource itself is polled.
+
+// pendingOperationPollingDelay is the delay between polling requests when no Retry-After header
+// is returned
+var pendingOperationPollingDelay = 15 * time.Second
+
+// pendingOperation tracks the polling URL of the long-running operation started by a client.
+type pendingOperation struct {
+	lock       sync.Mutex
+	pollingURL string
+}
+
+// track configures the client to record the polling URL of any long-running operation it starts.
+// Since the SDK clients are passed by value, this only affects the copy of the client passed in.
+func (o *pendingOperation) track(client *autorest.Client) {
+	sender := client.Sender
+	if sender == nil {
+		sender = &http.Client{}
+	}
+
+	client.Sender = autorest.DecorateSender(sender, o.recordPollingURL())
+}
+
+func (o *pendingOperation) recordPollingURL() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || resp == nil || r.Method == http.MethodGet {
+				return resp, err
+			}
+
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+				return resp, err
+			}
+
+			// the same order of preference as the SDK, which falls back to polling the resource itself
+			pollingURL := resp.Header.Get("Azure-AsyncOperation")
+			if pollingURL == "" {
+				pollingURL = resp.Header.Get("Location")
+			}
+			if pollingURL == "" && (r.Method == http.MethodPut || r.Method == http.MethodPatch) {
+				pollingURL = r.URL.String()
+			}
+
+			if pollingURL != "" {
+				log.Printf("[DEBUG] Long-running operation for %s %s is polled at %s", r.Method, r.URL, pollingURL)
+				o.lock.Lock()
+				o.pollingURL = pollingURL
+				o.lock.Unlock()
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// accepted returns whether a long-running operation was started by the client.
+func (o *pendingOperation) accepted() bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return o.pollingURL != ""
+}
+
+// persistIfAccepted stores the resource in the state once the request to create it has been
+// accepted, so that it's tracked even if waiting for the operation fails. When Terraform was
+// interrupted (i.e. the Provider's StopContext was cancelled) no error is returned, since the
+// operation continues in Azure and returning an error would taint the resource - instead any
+// subsequent Create/Update waits for it to complete. Otherwise (including when the Timeout's
+// exceeded) `err` is returned unchanged, so that the resource is tainted.
+func (o *pendingOperation) persistIfAccepted(stopCtx context.Context, d *schema.ResourceData, id string, err error) error {
+	if !o.accepted() {
+		return err
+	}
+
+	d.SetId(id)
+
+	if stopCtx.Err() == nil {
+		return err
+	}
+
+	log.Printf("[WARN] Interrupted whilst waiting for %q to finish provisioning - this continues in Azure: %+v", id, err)
+	return nil
+}
+
+// waitForPendingOperation waits for any long-running operation against the resource at the
+// specified URL to complete, by polling its `provisioningState`. Nothing is pending when the
+// resource doesn't exist (or its last operation failed), in which case no error is returned.
+func waitForPendingOperation(ctx context.Context, client autorest.Client, resourceURL string) error {
+	err := waitForOperation(ctx, client, resourceURL)
+	if err != nil && isOperationFailedError(err) {
+		log.Printf("[DEBUG] No pending operation to wait for at %s: %+v", resourceURL, err)
+		return nil
+	}
+
+	return err
+}
+
+// operationFailedError is returned when a long-running operation completes unsuccessfully.
+type operationFailedError struct {
+	pollingURL string
+	status     string
+	detail     *armErrorDetail
+}
+
+func (e operationFailedError) Error() string {
+	if e.detail == nil {
+		return fmt.Sprintf("Long-running operation %s finished with the status %q", e.pollingURL, e.status)
+	}
+
+	return fmt.Sprintf("Long-running operation %s finished with the status %q: Code=%q Message=%q", e.pollingURL, e.status, e.detail.Code, e.detail.Message)
+}
+
+// isOperationFailedError returns whether the error was caused by a long-running operation completing
+// unsuccessfully - rather than an error polling it.
+func isOperationFailedError(err error) bool {
+	_, ok := err.(operationFailedError)
+	return ok
+}
+
+// operationStatusResponse covers the shapes of the responses returned when polling an operation:
+// the `Azure-AsyncOperation` header returns an Operation Resource with a `status`; and polling the
+// resource directly returns the `provisioningState` within its properties.
+type operationStatusResponse struct {
+	Status     string          `json:"status"`
+	Error      *armErrorDetail `json:"error"`
+	Properties *struct {
+		ProvisioningState string `json:"provisioningState"`
+	} `json:"properties"`
+}
+
+// waitForOperation polls the long-running operation at the specified URL until it completes.
+func waitForOperation(ctx context.Context, client autorest.Client, pollingURL string) error {
+	for {
+		req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(pollingURL))
+		if err != nil {
+			return fmt.Errorf("Error building the polling request for %s: %+v", pollingURL, err)
+		}
+
+		resp, err := autorest.SendWithSender(client, req)
+		if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+			// the resource was removed when the operation failed
+			return operationFailedError{
+				pollingURL: pollingURL,
+				status:     "NotFound",
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Error polling the long-running operation %s: %+v", pollingURL, err)
+		}
+
+		status, detail, err := parseOperationStatus(resp)
+		if err != nil {
+			return fmt.Errorf("Error parsing the status of the long-running operation %s: %+v", pollingURL, err)
+		}
+
+		switch strings.ToLower(status) {
+		case "succeeded":
+			return nil
+		case "failed", "canceled":
+			return operationFailedError{
+				pollingURL: pollingURL,
+				status:     status,
+				detail:     detail,
+			}
+		}
+
+		delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if !ok {
+			delay = pendingOperationPollingDelay
+		}
+
+		log.Printf("[DEBUG] Long-running operation %s is %q - polling again in %s", pollingURL, status, delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return fmt.Errorf("Timed out waiting for the long-running operation %s: %+v", pollingURL, ctx.Err())
+		}
+	}
+}
+
+// parseOperationStatus returns the status of a long-running operation from the polling response,
+// inferring it from the Status Code when the response doesn't contain one.
+func parseOperationStatus(resp *http.Response) (string, *armErrorDetail, error) {
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var result operationStatusResponse
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if result.Status != "" {
+		return result.Status, result.Error, nil
+	}
+
+	if result.Properties != nil && result.Properties.ProvisioningState != "" {
+		return result.Properties.ProvisioningState, result.Error, nil
+	}
+
+	if resp.StatusCode == http.StatusAccepted {
+		return "InProgress", nil, nil
+	}
+
+	return "Succeeded", nil, nil
+}
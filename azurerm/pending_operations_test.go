@@ -0,0 +1,448 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-getter"
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func withShortPollingDelay() func() {
+	delay := pendingOperationPollingDelay
+	pendingOperationPollingDelay = time.Millisecond
+
+	return func() {
+		pendingOperationPollingDelay = delay
+	}
+}
+
+func TestPendingOperation_PersistedWhenInterrupted(t *testing.T) {
+	defer withShortPollingDelay()()
+
+	var completed int32
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/subscriptions/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.Header().Set("Azure-AsyncOperation", server.URL+"/operationStatuses/1")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"properties":{"provisioningState":"Accepted"}}`)
+		case http.MethodGet:
+			if atomic.LoadInt32(&completed) == 0 {
+				fmt.Fprint(w, `{"properties":{"provisioningState":"Running"}}`)
+				atomic.StoreInt32(&completed, 1)
+				return
+			}
+			fmt.Fprint(w, `{"properties":{"provisioningState":"Succeeded"}}`)
+		default:
+			t.Fatalf("Unexpected %s request", r.Method)
+		}
+	})
+	mux.HandleFunc("/operationStatuses/1", func(w http.ResponseWriter, r *http.Request) {
+		// Terraform is interrupted whilst the deployment is still running
+		cancel()
+		fmt.Fprint(w, `{"status":"InProgress"}`)
+	})
+
+	client := resources.NewDeploymentsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.PollingDelay = time.Millisecond
+
+	operation := &pendingOperation{}
+	operation.track(&client.Client)
+
+	d := schema.TestResourceDataRaw(t, resourceArmTemplateDeployment().Schema, map[string]interface{}{
+		"name":                "deployment1",
+		"resource_group_name": "group1",
+		"deployment_mode":     "Incremental",
+	})
+
+	_, errors := client.CreateOrUpdate("group1", "deployment1", resources.Deployment{
+		Properties: &resources.DeploymentProperties{Mode: resources.Incremental},
+	}, ctx.Done())
+	err := <-errors
+	if err == nil {
+		t.Fatalf("Expected an error since waiting for the deployment was interrupted")
+	}
+
+	// no error is returned, since this would taint the resource when it has provisioners
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1"
+	if err := operation.persistIfAccepted(ctx, d, id, err); err != nil {
+		t.Fatalf("Expected no error when interrupted but got: %+v", err)
+	}
+	if d.Id() != id {
+		t.Fatalf("Expected the ID to be %q but got %q", id, d.Id())
+	}
+
+	// the next run waits for the deployment to complete, rather than re-issuing the PUT
+	req, err := client.GetPreparer("group1", "deployment1")
+	if err != nil {
+		t.Fatalf("Error preparing the request: %+v", err)
+	}
+	if err := waitForPendingOperation(context.Background(), client.Client, req.URL.String()); err != nil {
+		t.Fatalf("Expected no error waiting for the pending operation but got: %+v", err)
+	}
+	if atomic.LoadInt32(&completed) == 0 {
+		t.Fatalf("Expected the deployment to have been polled until it completed")
+	}
+}
+
+func TestPendingOperation_NotPersistedUnlessAccepted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://example.com/operationResults/1")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	schemaMap := resourceArmTemplateDeployment().Schema
+	expected := fmt.Errorf("the operation failed")
+
+	operation := &pendingOperation{}
+	d := schema.TestResourceDataRaw(t, schemaMap, map[string]interface{}{})
+	if err := operation.persistIfAccepted(context.Background(), d, "/some/id", expected); err != expected {
+		t.Fatalf("Expected the error to be returned unchanged but got: %+v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected nothing to be persisted when the request wasn't accepted")
+	}
+
+	client := autorest.NewClientWithUserAgent("")
+	operation.track(&client)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, nil)
+	if _, err := autorest.SendWithSender(client, req); err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+	if operation.pollingURL != "http://example.com/operationResults/1" {
+		t.Fatalf("Expected the Location header to be recorded but got %q", operation.pollingURL)
+	}
+
+	// the operation failed rather than being interrupted, so the error's returned alongside the ID
+	d = schema.TestResourceDataRaw(t, schemaMap, map[string]interface{}{})
+	if err := operation.persistIfAccepted(context.Background(), d, "/some/id", expected); err != expected {
+		t.Fatalf("Expected the error to be returned unchanged but got: %+v", err)
+	}
+	if d.Id() != "/some/id" {
+		t.Fatalf("Expected the ID to be persisted once the request was accepted but got %q", d.Id())
+	}
+}
+
+func TestWaitForPendingOperation(t *testing.T) {
+	defer withShortPollingDelay()()
+
+	testCases := []struct {
+		name      string
+		responses []func(w http.ResponseWriter)
+	}{
+		{
+			name: "NotFound",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusNotFound) },
+			},
+		},
+		{
+			name: "InProgress",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"properties":{"provisioningState":"Updating"}}`) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"properties":{"provisioningState":"Succeeded"}}`) },
+			},
+		},
+		{
+			name: "Failed",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"properties":{"provisioningState":"Failed"}}`) },
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			count := int(atomic.AddInt32(&requests, 1))
+			if count > len(tc.responses) {
+				t.Fatalf("[%s] Expected polling to stop after %d requests", tc.name, len(tc.responses))
+			}
+			tc.responses[count-1](w)
+		}))
+
+		client := autorest.NewClientWithUserAgent("")
+		client.Sender = autorest.CreateSender(withErrorTranslation())
+
+		err := waitForPendingOperation(context.Background(), client, server.URL)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("[%s] Expected no error but got: %+v", tc.name, err)
+		}
+		if count := int(atomic.LoadInt32(&requests)); count != len(tc.responses) {
+			t.Fatalf("[%s] Expected %d requests but got %d", tc.name, len(tc.responses), count)
+		}
+	}
+}
+
+// TestPendingOperation_fakeArmInterruptedWithProvisioner runs Terraform directly, rather than through
+// `resource.Test` - since the TestCase in the vendored version of Terraform doesn't support Provisioners.
+func TestPendingOperation_fakeArmInterruptedWithProvisioner(t *testing.T) {
+	defer withShortPollingDelay()()
+
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctRandInt(t)
+	deploymentId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Resources/deployments/acctesttemplate-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.longRunning("Microsoft.Resources/deployments", http.MethodPut)
+
+	// the deployment is still running when Terraform is interrupted
+	server.pollsUntilComplete = math.MaxInt32
+	providers := server.providers()
+	time.AfterFunc(500*time.Millisecond, func() {
+		providers["azurerm"].(*schema.Provider).Stop()
+	})
+
+	provisioner := &terraform.MockResourceProvisioner{}
+	state, err := testApplyFakeArmConfig(providers, provisioner, nil, testPendingOperation_fakeArmTemplateDeployment(ri, "Standard_LRS", ""))
+	if err != nil {
+		t.Fatalf("Expected no error when the deployment was interrupted but got: %+v", err)
+	}
+
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok || rs.Primary == nil {
+		t.Fatalf("Expected %q to be stored in the state", resourceName)
+	}
+	if rs.Primary.ID != deploymentId {
+		t.Fatalf("Expected the ID to be %q but got %q", deploymentId, rs.Primary.ID)
+	}
+	if rs.Primary.Tainted {
+		t.Fatalf("Expected %q not to be tainted", resourceName)
+	}
+	if !provisioner.ApplyCalled {
+		t.Fatalf("Expected the provisioner to have been run")
+	}
+
+	// the deployment completes in Azure whilst the next run is waiting for it - which would otherwise Conflict
+	server.lock.Lock()
+	server.pollsUntilComplete = 1
+	server.lock.Unlock()
+	time.AfterFunc(500*time.Millisecond, server.completeOperations)
+
+	state, err = testApplyFakeArmConfig(server.providers(), provisioner, state, testPendingOperation_fakeArmTemplateDeployment(ri, "Standard_GRS", ""))
+	if err != nil {
+		t.Fatalf("Expected no error updating the deployment but got: %+v", err)
+	}
+	if actual := server.requestsFor(http.MethodPut, deploymentId); actual != 2 {
+		t.Fatalf("Expected the deployment to have been submitted twice but got %d", actual)
+	}
+	if actual := state.RootModule().Resources[resourceName].Primary.Attributes["parameters.storageAccountType"]; actual != "Standard_GRS" {
+		t.Fatalf("Expected the parameters to have been updated but got %q", actual)
+	}
+}
+
+func TestPendingOperation_fakeArmTimedOutWithProvisioner(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctRandInt(t)
+	deploymentId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Resources/deployments/acctesttemplate-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.longRunning("Microsoft.Resources/deployments", http.MethodPut)
+
+	// the deployment is still running when the Create Timeout is exceeded
+	server.pollsUntilComplete = math.MaxInt32
+
+	provisioner := &terraform.MockResourceProvisioner{}
+	state, err := testApplyFakeArmConfig(server.providers(), provisioner, nil, testPendingOperation_fakeArmTemplateDeployment(ri, "Standard_LRS", "1s"))
+	if err == nil {
+		t.Fatalf("Expected an error when the Create Timeout was exceeded")
+	}
+
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok || rs.Primary == nil {
+		t.Fatalf("Expected %q to be stored in the state", resourceName)
+	}
+	if rs.Primary.ID != deploymentId {
+		t.Fatalf("Expected the ID to be %q but got %q", deploymentId, rs.Primary.ID)
+	}
+	if !rs.Primary.Tainted {
+		t.Fatalf("Expected %q to be tainted", resourceName)
+	}
+}
+
+// testApplyFakeArmConfig refreshes, plans and applies the configuration using the Providers.
+func testApplyFakeArmConfig(resourceProviders map[string]terraform.ResourceProvider, provisioner terraform.ResourceProvisioner, state *terraform.State, config string) (*terraform.State, error) {
+	cfgPath, err := ioutil.TempDir("", "tf-test")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cfgPath)
+
+	if err := ioutil.WriteFile(filepath.Join(cfgPath, "main.tf"), []byte(config), 0644); err != nil {
+		return nil, err
+	}
+
+	mod, err := module.NewTreeModule("", cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := mod.Load(&getter.FolderStorage{StorageDir: filepath.Join(cfgPath, ".tfmodules")}, module.GetModeGet); err != nil {
+		return nil, err
+	}
+
+	providers := make(map[string]terraform.ResourceProviderFactory)
+	for name, p := range resourceProviders {
+		providers[name] = terraform.ResourceProviderFactoryFixed(p)
+	}
+
+	ctx, err := terraform.NewContext(&terraform.ContextOpts{
+		Module:    mod,
+		State:     state,
+		Providers: providers,
+		Provisioners: map[string]terraform.ResourceProvisionerFactory{
+			"local-exec": func() (terraform.ResourceProvisioner, error) {
+				return provisioner, nil
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ctx.Refresh(); err != nil {
+		return nil, err
+	}
+	if _, err := ctx.Plan(); err != nil {
+		return nil, err
+	}
+
+	return ctx.Apply()
+}
+
+func testPendingOperation_fakeArmTemplateDeployment(rInt int, storageAccountType string, createTimeout string) string {
+	timeouts := ""
+	if createTimeout != "" {
+		timeouts = fmt.Sprintf(`
+  timeouts {
+    create = "%s"
+  }
+`, createTimeout)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "West US"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  parameters {
+    storageAccountType = "%s"
+  }
+%s
+  provisioner "local-exec" {
+    command = "echo provisioned"
+  }
+}
+`, rInt, rInt, storageAccountType, timeouts)
+}
+
+func TestWaitForOperation(t *testing.T) {
+	defer withShortPollingDelay()()
+
+	testCases := []struct {
+		name      string
+		responses []func(w http.ResponseWriter)
+		failed    bool
+	}{
+		{
+			name: "AsyncOperationSucceeded",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"status":"InProgress"}`) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"status":"Succeeded"}`) },
+			},
+		},
+		{
+			name: "AsyncOperationFailed",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					fmt.Fprint(w, `{"status":"Failed","error":{"code":"AllocationFailed","message":"Allocation failed."}}`)
+				},
+			},
+			failed: true,
+		},
+		{
+			name: "LocationAccepted",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusAccepted) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusOK) },
+			},
+		},
+		{
+			name: "ProvisioningState",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"properties":{"provisioningState":"Creating"}}`) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"properties":{"provisioningState":"Succeeded"}}`) },
+			},
+		},
+		{
+			name: "ResourceRemoved",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusNotFound) },
+			},
+			failed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			count := int(atomic.AddInt32(&requests, 1))
+			if count > len(tc.responses) {
+				t.Fatalf("[%s] Expected polling to stop after %d requests", tc.name, len(tc.responses))
+			}
+			tc.responses[count-1](w)
+		}))
+
+		client := autorest.NewClientWithUserAgent("")
+		client.Sender = autorest.CreateSender(withErrorTranslation())
+
+		err := waitForOperation(context.Background(), client, server.URL)
+		server.Close()
+
+		if tc.failed {
+			if !isOperationFailedError(err) {
+				t.Fatalf("[%s] Expected the operation to have failed but got: %+v", tc.name, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("[%s] Expected no error but got: %+v", tc.name, err)
+		}
+		if count := int(atomic.LoadInt32(&requests)); count != len(tc.responses) {
+			t.Fatalf("[%s] Expected %d requests but got %d", tc.name, len(tc.responses), count)
+		}
+	}
+}
@@ -6,17 +6,17 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
-	"reflect"
 	"strings"
 	"sync"
 
-	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/mutexkv"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
-	riviera "github.com/jen20/riviera/azure"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -26,39 +26,82 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SUBSCRIPTION_ID", ""),
 			},
 
 			"client_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_ID", ""),
 			},
 
 			"client_secret": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
+			},
+
+			"msi_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			"metadata_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_URL", ""),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntBetween(0, 20),
+			},
+
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -69,7 +112,6 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			// These resources use the Azure ARM SDK
 			"azurerm_application_insights": resourceArmApplicationInsights(),
 			"azurerm_availability_set":     resourceArmAvailabilitySet(),
 			"azurerm_cdn_endpoint":         resourceArmCdnEndpoint(),
@@ -110,15 +152,20 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_network_security_rule":  resourceArmNetworkSecurityRule(),
 			"azurerm_public_ip":              resourceArmPublicIp(),
 
-			"azurerm_redis_cache": resourceArmRedisCache(),
-			"azurerm_route":       resourceArmRoute(),
-			"azurerm_route_table": resourceArmRouteTable(),
+			"azurerm_redis_cache":    resourceArmRedisCache(),
+			"azurerm_resource_group": resourceArmResourceGroup(),
+			"azurerm_route":          resourceArmRoute(),
+			"azurerm_route_table":    resourceArmRouteTable(),
 
+			"azurerm_search_service":          resourceArmSearchService(),
 			"azurerm_servicebus_namespace":    resourceArmServiceBusNamespace(),
 			"azurerm_servicebus_queue":        resourceArmServiceBusQueue(),
 			"azurerm_servicebus_subscription": resourceArmServiceBusSubscription(),
 			"azurerm_servicebus_topic":        resourceArmServiceBusTopic(),
+			"azurerm_sql_database":            resourceArmSqlDatabase(),
 			"azurerm_sql_elasticpool":         resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":       resourceArmSqlFirewallRule(),
+			"azurerm_sql_server":              resourceArmSqlServer(),
 			"azurerm_storage_account":         resourceArmStorageAccount(),
 			"azurerm_storage_blob":            resourceArmStorageBlob(),
 			"azurerm_storage_container":       resourceArmStorageContainer(),
@@ -127,24 +174,24 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_table":           resourceArmStorageTable(),
 			"azurerm_subnet":                  resourceArmSubnet(),
 
-			"azurerm_template_deployment":       resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":  resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":   resourceArmTrafficManagerProfile(),
-			"azurerm_virtual_machine_extension": resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine":           resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_scale_set": resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":           resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_peering":   resourceArmVirtualNetworkPeering(),
-
-			// These resources use the Riviera SDK
-			"azurerm_resource_group":    resourceArmResourceGroup(),
-			"azurerm_search_service":    resourceArmSearchService(),
-			"azurerm_sql_database":      resourceArmSqlDatabase(),
-			"azurerm_sql_firewall_rule": resourceArmSqlFirewallRule(),
-			"azurerm_sql_server":        resourceArmSqlServer(),
+			"azurerm_template_deployment":                  resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":             resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":              resourceArmTrafficManagerProfile(),
+			"azurerm_virtual_machine_extension":            resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine":                      resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_data_disk_attachment": resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_machine_scale_set":            resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                      resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_peering":              resourceArmVirtualNetworkPeering(),
 		},
 	}
 
+	for _, r := range p.ResourcesMap {
+		if _, ok := r.Schema["tags"]; ok {
+			r.Schema["outdated_tags"] = outdatedTagsSchema()
+		}
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -155,14 +202,47 @@ func Provider() terraform.ResourceProvider {
 type Config struct {
 	ManagementURL string
 
-	SubscriptionID           string
-	ClientID                 string
-	ClientSecret             string
-	TenantID                 string
-	Environment              string
-	SkipProviderRegistration bool
+	SubscriptionID            string
+	ClientID                  string
+	ClientSecret              string
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+	TenantID                  string
+	Environment               string
+	SkipProviderRegistration  bool
+
+	// Managed Service Identity
+	UseMsi      bool
+	MsiEndpoint string
+
+	// MetadataURL is the Resource Manager Metadata Endpoint of a custom
+	// Environment (such as Azure Stack), from which the Environment is loaded
+	MetadataURL       string
+	customEnvironment *azure.Environment
+
+	// MaxRetries is the number of times throttled or failed requests are retried
+	MaxRetries int
+
+	// DefaultTags are merged into the Tags of every resource which supports them
+	DefaultTags map[string]interface{}
+
+	// AccessToken is the token loaded from the Azure CLI, which is used
+	// when no other credentials have been specified
+	AccessToken *adal.Token
 
 	validateCredentialsOnce sync.Once
+
+	// resourceManagerEndpoint points every client at the specified endpoint without authenticating,
+	// which is used to run resources against the fake ARM server in the unit tests
+	resourceManagerEndpoint string
+
+	// skipAuthentication sends requests without credentials, which is used when replaying the
+	// traffic recorded from the acceptance tests
+	skipAuthentication bool
+
+	// sender replaces the HTTP Client used by every client, which is used to record & replay the
+	// traffic from the acceptance tests
+	sender autorest.Sender
 }
 
 func (c *Config) validate() error {
@@ -171,11 +251,37 @@ func (c *Config) validate() error {
 	if c.SubscriptionID == "" {
 		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
 	}
-	if c.ClientID == "" {
+	// the Client ID is optional when using a Managed Service Identity, since the VM's identity is used by default
+	if c.ClientID == "" && !c.UseMsi {
 		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
 	}
-	if c.ClientSecret == "" {
-		err = multierror.Append(err, fmt.Errorf("Client Secret must be configured for the AzureRM provider"))
+
+	credentials := 0
+	if c.ClientSecret != "" {
+		credentials++
+	}
+	if c.ClientCertificatePath != "" {
+		credentials++
+	}
+	if c.UseMsi {
+		credentials++
+	}
+	if c.AccessToken != nil {
+		credentials++
+	}
+	switch credentials {
+	case 0:
+		err = multierror.Append(err, fmt.Errorf("Either a Client Secret, a Client Certificate or a Managed Service Identity must be configured for the AzureRM provider, or you must be logged in to the Azure CLI (using `az login`)"))
+	case 1:
+		// exactly one set of credentials has been specified
+	default:
+		err = multierror.Append(err, fmt.Errorf("Only one of a Client Secret, a Client Certificate, a Managed Service Identity or the Azure CLI credentials can be used to authenticate the AzureRM provider"))
+	}
+	if c.MsiEndpoint != "" && !c.UseMsi {
+		err = multierror.Append(err, fmt.Errorf("An MSI Endpoint was specified but `use_msi` isn't enabled"))
+	}
+	if c.ClientCertificatePassword != "" && c.ClientCertificatePath == "" {
+		err = multierror.Append(err, fmt.Errorf("A Client Certificate Password was specified without a Client Certificate Path"))
 	}
 	if c.TenantID == "" {
 		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
@@ -189,147 +295,84 @@ func (c *Config) validate() error {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
-		config := &Config{
-			SubscriptionID:           d.Get("subscription_id").(string),
-			ClientID:                 d.Get("client_id").(string),
-			ClientSecret:             d.Get("client_secret").(string),
-			TenantID:                 d.Get("tenant_id").(string),
-			Environment:              d.Get("environment").(string),
-			SkipProviderRegistration: d.Get("skip_provider_registration").(bool),
-		}
-
-		if err := config.validate(); err != nil {
-			return nil, err
-		}
-
-		client, err := config.getArmClient()
-		if err != nil {
-			return nil, err
-		}
-
-		client.StopContext = p.StopContext()
-
-		// replaces the context between tests
-		p.MetaReset = func() error {
-			client.StopContext = p.StopContext()
-			return nil
-		}
+		return configureProvider(p, expandProviderConfig(d))
+	}
+}
 
-		// List all the available providers and their registration state to avoid unnecessary
-		// requests. This also lets us check if the provider credentials are correct.
-		providerList, err := client.providers.List(nil, "")
-		if err != nil {
-			return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
-				"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-				"error: %s", err)
-		}
+// expandProviderConfig builds the Config from the fields in the Provider block.
+func expandProviderConfig(d *schema.ResourceData) *Config {
+	return &Config{
+		SubscriptionID:            d.Get("subscription_id").(string),
+		ClientID:                  d.Get("client_id").(string),
+		ClientSecret:              d.Get("client_secret").(string),
+		ClientCertificatePath:     d.Get("client_certificate_path").(string),
+		ClientCertificatePassword: d.Get("client_certificate_password").(string),
+		TenantID:                  d.Get("tenant_id").(string),
+		Environment:               d.Get("environment").(string),
+		SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+		UseMsi:                    d.Get("use_msi").(bool),
+		MsiEndpoint:               d.Get("msi_endpoint").(string),
+		MetadataURL:               d.Get("metadata_url").(string),
+		MaxRetries:                d.Get("max_retries").(int),
+		DefaultTags:               d.Get("default_tags").(map[string]interface{}),
+	}
+}
 
-		if !config.SkipProviderRegistration {
-			err = registerAzureResourceProvidersWithSubscription(*providerList.Value, client.providers)
-			if err != nil {
-				return nil, err
+// configureProvider loads & validates the credentials for the Config, before building the ArmClient
+// and registering the Resource Providers used by Terraform.
+func configureProvider(p *schema.Provider, config *Config) (interface{}, error) {
+	if !config.skipAuthentication {
+		if config.ClientSecret == "" && config.ClientCertificatePath == "" && !config.UseMsi {
+			log.Printf("[DEBUG] No Client Secret, Client Certificate or Managed Service Identity specified - loading credentials from the Azure CLI")
+			if err := config.loadTokensFromAzureCLI(); err != nil {
+				return nil, fmt.Errorf("No Client Secret, Client Certificate or Managed Service Identity was specified and the Azure CLI credentials couldn't be loaded: %+v", err)
 			}
 		}
 
-		return client, nil
+		if err := config.validate(); err != nil {
+			return nil, err
+		}
 	}
-}
 
-func registerProviderWithSubscription(providerName string, client resources.ProvidersClient) error {
-	_, err := client.Register(providerName)
+	client, err := config.getArmClient()
 	if err != nil {
-		return fmt.Errorf("Cannot register provider %s with Azure Resource Manager: %s.", providerName, err)
+		return nil, err
 	}
 
-	return nil
-}
+	client.StopContext = p.StopContext()
 
-var providerRegistrationOnce sync.Once
-
-// registerAzureResourceProvidersWithSubscription uses the providers client to register
-// all Azure resource providers which the Terraform provider may require (regardless of
-// whether they are actually used by the configuration or not). It was confirmed by Microsoft
-// that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(providerList []resources.Provider, client resources.ProvidersClient) error {
-	var err error
-	providerRegistrationOnce.Do(func() {
-		providers := map[string]struct{}{
-			"Microsoft.Compute":           struct{}{},
-			"Microsoft.Cache":             struct{}{},
-			"Microsoft.ContainerRegistry": struct{}{},
-			"Microsoft.ContainerService":  struct{}{},
-			"Microsoft.Network":           struct{}{},
-			"Microsoft.Cdn":               struct{}{},
-			"Microsoft.Storage":           struct{}{},
-			"Microsoft.Sql":               struct{}{},
-			"Microsoft.Search":            struct{}{},
-			"Microsoft.Resources":         struct{}{},
-			"Microsoft.ServiceBus":        struct{}{},
-			"Microsoft.KeyVault":          struct{}{},
-			"Microsoft.EventHub":          struct{}{},
-		}
+	// replaces the context between tests
+	p.MetaReset = func() error {
+		client.setStopContext(p.StopContext())
+		return nil
+	}
 
-		// filter out any providers already registered
-		for _, p := range providerList {
-			if _, ok := providers[*p.Namespace]; !ok {
-				continue
-			}
+	// List all the available providers and their registration state to avoid unnecessary
+	// requests. This also lets us check if the provider credentials are correct.
+	providerList, err := client.providers().List(nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
+			"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
+			"error: %s", err)
+	}
 
-			if strings.ToLower(*p.RegistrationState) == "registered" {
-				log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
-				delete(providers, *p.Namespace)
-			}
-		}
+	if config.MetadataURL != "" {
+		// custom Environments (such as Azure Stack) only support a subset of the API Versions
+		// available in Azure, so we check requests are supported before sending them
+		client.supportedAPIVersions = newResourceProviderAPIVersions(*providerList.Value)
+	}
 
-		var wg sync.WaitGroup
-		wg.Add(len(providers))
-		for providerName := range providers {
-			go func(p string) {
-				defer wg.Done()
-				log.Printf("[DEBUG] Registering provider with namespace %s\n", p)
-				if innerErr := registerProviderWithSubscription(p, client); err != nil {
-					err = innerErr
-				}
-			}(providerName)
-		}
-		wg.Wait()
-	})
+	if !config.SkipProviderRegistration {
+		// the namespaces which aren't registered are registered the first time they're used
+		client.providerRegistrations = newResourceProviderRegistrations(client.providers, *providerList.Value)
+	}
 
-	return err
+	return client, nil
 }
 
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
-func azureStateRefreshFunc(resourceURI string, client *ArmClient, command riviera.APICall) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		req := client.rivieraClient.NewRequestForURI(resourceURI)
-		req.Command = command
-
-		res, err := req.Execute()
-		if err != nil {
-			return nil, "", fmt.Errorf("Error executing %T command in azureStateRefreshFunc", req.Command)
-		}
-
-		var value reflect.Value
-		if reflect.ValueOf(res.Parsed).Kind() == reflect.Ptr {
-			value = reflect.ValueOf(res.Parsed).Elem()
-		} else {
-			value = reflect.ValueOf(res.Parsed)
-		}
-
-		for i := 0; i < value.NumField(); i++ { // iterates through every struct type field
-			tag := value.Type().Field(i).Tag // returns the tag string
-			tagValue := tag.Get("mapstructure")
-			if tagValue == "provisioningState" {
-				return res.Parsed, value.Field(i).Elem().String(), nil
-			}
-		}
-
-		panic(fmt.Errorf("azureStateRefreshFunc called on structure %T with no mapstructure:provisioningState tag. This is a bug", res.Parsed))
-	}
-}
-
 // Resource group names can be capitalised, but we store them in lowercase.
 // Use a custom diff function to avoid creation of new resources.
 func resourceAzurermResourceGroupNameDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Resource Provider namespaces (e.g. `Microsoft.Compute`) need to be registered with the Subscription
+// before they can be used. Rather than registering every namespace the Provider supports when it's
+// configured (which requires permissions at the Subscription scope), each namespace is registered the
+// first time a request is made to it - that is, the first time a resource from that service is
+// refreshed, created, updated or deleted.
+
+// providerRegistrationPollingDelay is the delay between checks of whether a namespace is registered
+var providerRegistrationPollingDelay = 10 * time.Second
+
+// providerRegistrationTimeout is how long to wait for a namespace to become registered
+var providerRegistrationTimeout = 10 * time.Minute
+
+// resourceProviderRegistrations tracks which Resource Provider namespaces are registered with the
+// Subscription, registering each one the first time it's used.
+type resourceProviderRegistrations struct {
+	client func() resources.ProvidersClient
+
+	lock       sync.Mutex
+	namespaces map[string]*namespaceRegistration
+}
+
+// namespaceRegistration is the registration of a single namespace, which is attempted by one request
+// at a time - and retried by the next request should it fail.
+type namespaceRegistration struct {
+	lock       sync.Mutex
+	registered bool
+}
+
+func newResourceProviderRegistrations(client func() resources.ProvidersClient, providers []resources.Provider) *resourceProviderRegistrations {
+	registrations := &resourceProviderRegistrations{
+		client:     client,
+		namespaces: make(map[string]*namespaceRegistration),
+	}
+
+	// there's no need to check the namespaces which are already registered
+	for _, provider := range providers {
+		if provider.Namespace == nil || provider.RegistrationState == nil {
+			continue
+		}
+
+		if strings.EqualFold(*provider.RegistrationState, "Registered") {
+			registration := &namespaceRegistration{registered: true}
+			registrations.namespaces[strings.ToLower(*provider.Namespace)] = registration
+		}
+	}
+
+	return registrations
+}
+
+// ensureRegistered registers the namespace with the Subscription if it isn't already, waiting until
+// the registration completes.
+func (r *resourceProviderRegistrations) ensureRegistered(namespace string) error {
+	r.lock.Lock()
+	registration, ok := r.namespaces[strings.ToLower(namespace)]
+	if !ok {
+		registration = &namespaceRegistration{}
+		r.namespaces[strings.ToLower(namespace)] = registration
+	}
+	r.lock.Unlock()
+
+	registration.lock.Lock()
+	defer registration.lock.Unlock()
+
+	if registration.registered {
+		return nil
+	}
+
+	if err := registerResourceProvider(r.client(), namespace); err != nil {
+		return err
+	}
+
+	registration.registered = true
+	return nil
+}
+
+// registerResourceProvider registers the namespace with the Subscription, polling until it's registered.
+func registerResourceProvider(client resources.ProvidersClient, namespace string) error {
+	existing, err := client.Get(namespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving the registration state of the Resource Provider %q: %+v", namespace, err)
+	}
+	if existing.RegistrationState != nil && strings.EqualFold(*existing.RegistrationState, "Registered") {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Registering the Resource Provider %q with the Subscription", namespace)
+	if _, err := client.Register(namespace); err != nil {
+		return fmt.Errorf("Error registering the Resource Provider %q with the Subscription: %+v. "+
+			"The Service Principal may not have permission to register Resource Providers - in which case %q "+
+			"needs to be registered by an administrator and `skip_provider_registration` set to `true`.", namespace, err, namespace)
+	}
+
+	timeout := time.Now().Add(providerRegistrationTimeout)
+	for {
+		provider, err := client.Get(namespace, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving the registration state of the Resource Provider %q: %+v", namespace, err)
+		}
+
+		state := ""
+		if provider.RegistrationState != nil {
+			state = *provider.RegistrationState
+		}
+
+		if strings.EqualFold(state, "Registered") {
+			log.Printf("[DEBUG] The Resource Provider %q is registered", namespace)
+			return nil
+		}
+
+		if time.Now().After(timeout) {
+			return fmt.Errorf("Timed out waiting for the Resource Provider %q to be registered (the Registration State is %q)", namespace, state)
+		}
+
+		log.Printf("[DEBUG] The Resource Provider %q is %q - checking again in %s", namespace, state, providerRegistrationPollingDelay)
+		time.Sleep(providerRegistrationPollingDelay)
+	}
+}
+
+// withResourceProviderRegistration returns a SendDecorator which registers the Resource Provider
+// namespaces used in the request, before it's sent.
+func withResourceProviderRegistration(client *ArmClient) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if client.providerRegistrations != nil {
+				for _, namespace := range resourceProviderNamespaces(r.URL) {
+					if err := client.providerRegistrations.ensureRegistered(namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+// resourceProviderNamespaces returns the Resource Provider namespaces referenced in the URL, excluding
+// requests to the Resource Providers API itself (e.g. to register a namespace).
+func resourceProviderNamespaces(requestURL *url.URL) []string {
+	segments := strings.Split(strings.Trim(requestURL.Path, "/"), "/")
+
+	if len(segments) >= 3 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "providers") {
+		if len(segments) <= 4 {
+			return nil
+		}
+		if len(segments) == 5 && (strings.EqualFold(segments[4], "register") || strings.EqualFold(segments[4], "unregister")) {
+			return nil
+		}
+	}
+
+	namespaces := make([]string, 0)
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+1 < len(segments) {
+			namespaces = append(namespaces, segments[i+1])
+		}
+	}
+	return namespaces
+}
@@ -0,0 +1,184 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestResourceProviderNamespaces(t *testing.T) {
+	testCases := []struct {
+		url      string
+		expected []string
+	}{
+		{
+			url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1?api-version=2016-04-30-preview",
+			expected: []string{"Microsoft.Compute"},
+		},
+		{
+			// listing resources across the Subscription
+			url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/virtualNetworks",
+			expected: []string{"Microsoft.Network"},
+		},
+		{
+			// extension resources reference both namespaces
+			url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/locks/lock1",
+			expected: []string{"Microsoft.Storage", "Microsoft.Authorization"},
+		},
+		{
+			url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1",
+			expected: []string{},
+		},
+		{
+			// the Resource Providers API itself
+			url: "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers",
+		},
+		{
+			url: "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute",
+		},
+		{
+			url: "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute/register",
+		},
+	}
+
+	for _, tc := range testCases {
+		requestURL, err := url.Parse(tc.url)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", tc.url, err)
+		}
+
+		actual := resourceProviderNamespaces(requestURL)
+		if len(actual) == 0 && len(tc.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(tc.expected, actual) {
+			t.Fatalf("Expected the namespaces for %q to be %+v but got %+v", tc.url, tc.expected, actual)
+		}
+	}
+}
+
+func TestResourceProviderRegistration(t *testing.T) {
+	delay := providerRegistrationPollingDelay
+	providerRegistrationPollingDelay = time.Millisecond
+	defer func() {
+		providerRegistrationPollingDelay = delay
+	}()
+
+	var lock sync.Mutex
+	registrations := map[string]int{}
+	polls := map[string]int{}
+	forbidden := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		switch {
+		case len(segments) == 5 && segments[4] == "register":
+			// Microsoft.Cache can't be registered the first time, e.g. whilst permissions propagate
+			if segments[3] == "Microsoft.Cache" && forbidden[segments[3]] == 0 {
+				forbidden[segments[3]]++
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error":{"code":"AuthorizationFailed","message":"The client does not have authorization to perform action 'Microsoft.Cache/register/action'."}}`)
+				return
+			}
+			registrations[segments[3]]++
+			fmt.Fprintf(w, `{"namespace":%q,"registrationState":"Registering"}`, segments[3])
+
+		case len(segments) == 4:
+			// the namespace becomes registered after being polled a few times
+			polls[segments[3]]++
+			state := "Registering"
+			if polls[segments[3]] >= 3 {
+				state = "Registered"
+			}
+			fmt.Fprintf(w, `{"namespace":%q,"registrationState":%q}`, segments[3], state)
+
+		default:
+			fmt.Fprintf(w, `{"id":%q,"name":"example","location":"westeurope"}`, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		SubscriptionID:          fakeArmSubscriptionID,
+		Environment:             "public",
+		resourceManagerEndpoint: server.URL,
+	}
+	client, err := config.getArmClient()
+	if err != nil {
+		t.Fatalf("Error building the ArmClient: %+v", err)
+	}
+	client.providerRegistrations = newResourceProviderRegistrations(client.providers, []resources.Provider{
+		{
+			Namespace:         to.StringPtr("Microsoft.Network"),
+			RegistrationState: to.StringPtr("Registered"),
+		},
+		{
+			Namespace:         to.StringPtr("Microsoft.Compute"),
+			RegistrationState: to.StringPtr("NotRegistered"),
+		},
+	})
+
+	// concurrent requests to the same namespace only register it once
+	var wg sync.WaitGroup
+	errors := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.availSetClient().Get("group1", "set1"); err != nil {
+				errors <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errors)
+	for err := range errors {
+		t.Fatalf("Unexpected error retrieving the Availability Set: %+v", err)
+	}
+
+	if _, err := client.vnetClient().Get("group1", "network1", ""); err != nil {
+		t.Fatalf("Unexpected error retrieving the Virtual Network: %+v", err)
+	}
+
+	lock.Lock()
+	if registrations["Microsoft.Compute"] != 1 {
+		t.Fatalf("Expected Microsoft.Compute to be registered once but got %d", registrations["Microsoft.Compute"])
+	}
+	if polls["Microsoft.Compute"] != 3 {
+		t.Fatalf("Expected the registration of Microsoft.Compute to be polled until it's registered but got %d polls", polls["Microsoft.Compute"])
+	}
+	if _, ok := registrations["Microsoft.Network"]; ok {
+		t.Fatalf("Expected Microsoft.Network not to be registered since it was already registered")
+	}
+	lock.Unlock()
+
+	// the error names the namespace which couldn't be registered
+	_, err = client.redisClient().Get("group1", "cache1")
+	if err == nil || !strings.Contains(err.Error(), `"Microsoft.Cache"`) {
+		t.Fatalf("Expected an error registering Microsoft.Cache but got: %+v", err)
+	}
+
+	// a failed registration is retried by the next request
+	if _, err := client.redisClient().Get("group1", "cache1"); err != nil {
+		t.Fatalf("Unexpected error retrieving the Redis Cache: %+v", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if registrations["Microsoft.Cache"] != 1 {
+		t.Fatalf("Expected Microsoft.Cache to be registered once it's retried but got %d registrations", registrations["Microsoft.Cache"])
+	}
+}
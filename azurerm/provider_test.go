@@ -29,6 +29,15 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	if cassetteMode() == cassetteModeReplay {
+		if testLocation() == "" {
+			t.Fatal("ARM_TEST_LOCATION must be set to the location used to record the cassettes")
+		}
+
+		configureCassette(t)
+		return
+	}
+
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	clientID := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
@@ -38,6 +47,8 @@ func testAccPreCheck(t *testing.T) {
 	if subscriptionID == "" || clientID == "" || clientSecret == "" || tenantID == "" || testLocation == "" {
 		t.Fatal("ARM_SUBSCRIPTION_ID, ARM_CLIENT_ID, ARM_CLIENT_SECRET, ARM_TENANT_ID and ARM_TEST_LOCATION must be set for acceptance tests")
 	}
+
+	configureCassette(t)
 }
 
 func testLocation() string {
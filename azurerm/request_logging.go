@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const redactedValue = "[REDACTED]"
+
+// redactedHeaders are the HTTP Headers which contain credentials
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// redactedFields are the (lower-cased) names of JSON fields which contain secrets, such
+// as passwords, access keys and connection strings, in requests to & responses from ARM
+var redactedFields = map[string]struct{}{
+	"adminpassword":              {},
+	"administratorloginpassword": {},
+	"connectionstring":           {},
+	"customdata":                 {},
+	"key1":                       {},
+	"key2":                       {},
+	"primaryconnectionstring":    {},
+	"primarykey":                 {},
+	"protectedsettings":          {},
+	"secondaryconnectionstring":  {},
+	"secondarykey":               {},
+	"sharedkey":                  {},
+}
+
+// fields containing any of these values are also treated as secrets
+var redactedFieldSubstrings = []string{
+	"password",
+	"secret",
+}
+
+// shouldLogRequestBodies returns whether the (redacted) bodies of requests & responses should
+// be logged, which is opt-in by setting TF_LOG to TRACE since these can contain sensitive data
+func shouldLogRequestBodies() bool {
+	return strings.EqualFold(os.Getenv("TF_LOG"), "TRACE")
+}
+
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			logBodies := shouldLogRequestBodies()
+
+			// dump the request headers to wire format, with the credentials redacted
+			logged := *r
+			logged.Header = redactHeaders(r.Header)
+			if dump, err := httputil.DumpRequestOut(&logged, false); err == nil {
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+			}
+
+			if logBodies && r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				r.Body.Close()
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				if err == nil {
+					log.Printf("[TRACE] AzureRM Request Body for %s: \n%s\n", r.URL, redactBody(body))
+				}
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				loggedResp := *resp
+				loggedResp.Header = redactHeaders(resp.Header)
+				if dump, err := httputil.DumpResponse(&loggedResp, false); err == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				} else {
+					// fallback to basic message
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+				}
+
+				if logBodies && resp.Body != nil {
+					body, err := ioutil.ReadAll(resp.Body)
+					resp.Body.Close()
+					resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+					if err == nil {
+						log.Printf("[TRACE] AzureRM Response Body for %s: \n%s\n", r.URL, redactBody(body))
+					}
+				}
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+			}
+			return resp, err
+		})
+	}
+}
+
+// redactHeaders returns a copy of the HTTP Headers with any credentials redacted.
+func redactHeaders(headers http.Header) http.Header {
+	output := make(http.Header, len(headers))
+	for k, v := range headers {
+		output[k] = v
+	}
+
+	for _, header := range redactedHeaders {
+		if output.Get(header) != "" {
+			output.Set(header, redactedValue)
+		}
+	}
+
+	return output
+}
+
+// redactBody returns the body of a request or response with the values of any secret
+// fields redacted. Since we can't tell which values are sensitive in non-JSON bodies
+// only their length is returned.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return fmt.Sprintf("[%d bytes of non-JSON content]", len(body))
+	}
+
+	redacted, err := json.Marshal(redactJSON(parsed, false))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of JSON content]", len(body))
+	}
+
+	return string(redacted)
+}
+
+// redactJSON walks the decoded JSON, redacting the values of any secret fields. Where
+// the value is within a `keys` block (as returned by the ListKeys API's) all values are
+// redacted, since the key names are arbitrary.
+func redactJSON(input interface{}, withinKeys bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if isSecretField(key) || (withinKeys && strings.EqualFold(key, "value")) {
+				output[key] = redactedValue
+				continue
+			}
+
+			output[key] = redactJSON(value, withinKeys || strings.EqualFold(key, "keys"))
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, len(v))
+		for i, value := range v {
+			output[i] = redactJSON(value, withinKeys)
+		}
+		return output
+	}
+
+	return input
+}
+
+func isSecretField(name string) bool {
+	lowered := strings.ToLower(name)
+	if _, ok := redactedFields[lowered]; ok {
+		return true
+	}
+
+	for _, substring := range redactedFieldSubstrings {
+		if strings.Contains(lowered, substring) {
+			return true
+		}
+	}
+
+	return false
+}
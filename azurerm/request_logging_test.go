@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func captureRequestLog(t *testing.T, logLevel string, f func()) string {
+	previousLevel, hadLevel := os.LookupEnv("TF_LOG")
+	os.Setenv("TF_LOG", logLevel)
+	defer func() {
+		if hadLevel {
+			os.Setenv("TF_LOG", previousLevel)
+		} else {
+			os.Unsetenv("TF_LOG")
+		}
+	}()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	f()
+	return buf.String()
+}
+
+func TestWithRequestLogging_RedactsHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer super-secret-token" {
+			t.Fatalf("Expected the original Authorization header to be sent but got %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	output := captureRequestLog(t, "DEBUG", func() {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set("Authorization", "Bearer super-secret-token")
+		sender := autorest.DecorateSender(&http.Client{}, withRequestLogging())
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	})
+
+	for _, secret := range []string{"super-secret-token", "secret-cookie"} {
+		if strings.Contains(output, secret) {
+			t.Fatalf("Expected %q to be redacted from the log but got:\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, redactedValue) {
+		t.Fatalf("Expected the redacted headers to be logged but got:\n%s", output)
+	}
+}
+
+func TestWithRequestLogging_Bodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), "P@ssw0rd1234") {
+			t.Fatalf("Expected the original request body to be sent but got %q", string(body))
+		}
+		fmt.Fprint(w, `{"keys":[{"keyName":"key1","value":"account-key-1"}]}`)
+	}))
+	defer server.Close()
+
+	makeRequest := func() {
+		req, _ := http.NewRequest(http.MethodPut, server.URL, bytes.NewReader([]byte(`{"properties":{"adminPassword":"P@ssw0rd1234","name":"example"}}`)))
+		sender := autorest.DecorateSender(&http.Client{}, withRequestLogging())
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		if !strings.Contains(string(body), "account-key-1") {
+			t.Fatalf("Expected the original response body to be returned but got %q", string(body))
+		}
+	}
+
+	output := captureRequestLog(t, "DEBUG", makeRequest)
+	if strings.Contains(output, "Body") {
+		t.Fatalf("Expected bodies not to be logged at DEBUG but got:\n%s", output)
+	}
+
+	output = captureRequestLog(t, "TRACE", makeRequest)
+	for _, secret := range []string{"P@ssw0rd1234", "account-key-1"} {
+		if strings.Contains(output, secret) {
+			t.Fatalf("Expected %q to be redacted from the log but got:\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, `"name":"example"`) {
+		t.Fatalf("Expected non-secret fields to be logged but got:\n%s", output)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{``, ``},
+		{`not json`, `[8 bytes of non-JSON content]`},
+		{`{"name":"example"}`, `{"name":"example"}`},
+		{`{"properties":{"osProfile":{"adminUsername":"admin","adminPassword":"secret"}}}`, `{"properties":{"osProfile":{"adminPassword":"[REDACTED]","adminUsername":"admin"}}}`},
+		{`{"PrimaryKey":"abc","secondaryConnectionString":"def"}`, `{"PrimaryKey":"[REDACTED]","secondaryConnectionString":"[REDACTED]"}`},
+		{`{"properties":{"protectedSettings":{"commandToExecute":"echo"}}}`, `{"properties":{"protectedSettings":"[REDACTED]"}}`},
+		{`{"keys":[{"keyName":"key1","permissions":"Full","value":"abc"}]}`, `{"keys":[{"keyName":"key1","permissions":"Full","value":"[REDACTED]"}]}`},
+		{`{"access_token":"abc","client_secret":"def","value":"ghi"}`, `{"access_token":"abc","client_secret":"[REDACTED]","value":"ghi"}`},
+	}
+
+	for _, tc := range testCases {
+		if actual := redactBody([]byte(tc.input)); actual != tc.expected {
+			t.Fatalf("Expected %q to be redacted as %q but got %q", tc.input, tc.expected, actual)
+		}
+	}
+}
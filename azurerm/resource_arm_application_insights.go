@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/appinsights"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceArmApplicationInsights() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,7 +69,7 @@ func resourceArmApplicationInsights() *schema.Resource {
 }
 
 func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appInsightsClient
+	client := meta.(*ArmClient).appInsightsClient()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights creation.")
 
@@ -70,7 +77,10 @@ func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta i
 	resGroup := d.Get("resource_group_name").(string)
 	applicationType := d.Get("application_type").(string)
 	location := d.Get("location").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	applicationInsightsComponentProperties := appinsights.ApplicationInsightsComponentProperties{
 		ApplicationID:   &name,
@@ -85,7 +95,7 @@ func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta i
 		Tags: expandTags(tags),
 	}
 
-	_, err := client.CreateOrUpdate(resGroup, name, insightProperties)
+	_, err = client.CreateOrUpdate(resGroup, name, insightProperties)
 	if err != nil {
 		return err
 	}
@@ -104,9 +114,9 @@ func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appInsightsClient
+	client := meta.(*ArmClient).appInsightsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseApplicationInsightsID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -114,7 +124,7 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Reading AzureRM Application Insights '%s'", id)
 
 	resGroup := id.ResourceGroup
-	name := id.Path["components"]
+	name := id.Name
 
 	resp, err := client.Get(resGroup, name)
 	if err != nil {
@@ -135,20 +145,20 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 		d.Set("instrumentation_key", props.InstrumentationKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
-	AppInsightsClient := meta.(*ArmClient).appInsightsClient
+	AppInsightsClient := meta.(*ArmClient).appInsightsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseApplicationInsightsID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["components"]
+	name := id.Name
 
 	log.Printf("[DEBUG] Deleting AzureRM Application Insights '%s' (resource group '%s')", name, resGroup)
 
@@ -2,17 +2,58 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_application_insights", &resource.Sweeper{
+		Name: "azurerm_application_insights",
+		F:    testSweepApplicationInsights,
+	})
+}
+
+func testSweepApplicationInsights(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.appInsightsClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Application Insights..")
+	results, err := client.List()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Application Insights: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, component := range *results.Value {
+				resources = append(resources, sweepableResource{component.ID, component.Name, component.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Application Insights", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMApplicationInsights_basicWeb(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMApplicationInsights_basicWeb(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +73,7 @@ func TestAccAzureRMApplicationInsights_basicWeb(t *testing.T) {
 
 func TestAccAzureRMApplicationInsights_basicOther(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMApplicationInsights_basicOther(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -51,7 +92,7 @@ func TestAccAzureRMApplicationInsights_basicOther(t *testing.T) {
 }
 
 func testCheckAzureRMApplicationInsightsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).appInsightsClient
+	conn := testAccProvider.Meta().(*ArmClient).appInsightsClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_application_insights" {
@@ -89,7 +130,7 @@ func testCheckAzureRMApplicationInsightsExists(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: no resource group found in state for App Insights: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).appInsightsClient
+		conn := testAccProvider.Meta().(*ArmClient).appInsightsClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmAvailabilitySet() *schema.Resource {
@@ -21,6 +22,12 @@ func resourceArmAvailabilitySet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -65,7 +72,7 @@ func resourceArmAvailabilitySet() *schema.Resource {
 }
 
 func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).availSetClient
+	client := meta.(*ArmClient).availSetClient()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Availability Set creation.")
 
@@ -75,14 +82,17 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 	updateDomainCount := d.Get("platform_update_domain_count").(int)
 	faultDomainCount := d.Get("platform_fault_domain_count").(int)
 	managed := d.Get("managed").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	availSet := compute.AvailabilitySet{
 		Name:     &name,
 		Location: &location,
 		AvailabilitySetProperties: &compute.AvailabilitySetProperties{
-			PlatformFaultDomainCount:  azure.Int32(int32(faultDomainCount)),
-			PlatformUpdateDomainCount: azure.Int32(int32(updateDomainCount)),
+			PlatformFaultDomainCount:  to.Int32Ptr(int32(faultDomainCount)),
+			PlatformUpdateDomainCount: to.Int32Ptr(int32(updateDomainCount)),
 		},
 		Tags: expandTags(tags),
 	}
@@ -105,14 +115,14 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).availSetClient
+	client := meta.(*ArmClient).availSetClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseAvailabilitySetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name := id.Name
 
 	resp, err := client.Get(resGroup, name)
 	if err != nil {
@@ -134,20 +144,20 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("managed", strings.EqualFold(*resp.Sku.Name, "Aligned"))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmAvailabilitySetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).availSetClient
+	client := meta.(*ArmClient).availSetClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseAvailabilitySetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name := id.Name
 
 	_, err = client.Delete(resGroup, name)
 
@@ -2,17 +2,61 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_availability_set", &resource.Sweeper{
+		Name: "azurerm_availability_set",
+		F:    testSweepAvailabilitySets,
+		Dependencies: []string{
+			"azurerm_virtual_machine",
+		},
+	})
+}
+
+func testSweepAvailabilitySets(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.availSetClient()
+	resources := make([]sweepableResource, 0)
+
+	resourceGroups, err := acceptanceTestResourceGroups(armClient, region)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceGroup := range resourceGroups {
+		log.Printf("Retrieving the Availability Sets in Resource Group '%s'..", resourceGroup)
+		results, err := client.List(resourceGroup)
+		if err != nil {
+			return fmt.Errorf("Error listing Availability Sets in Resource Group %q: %+v", resourceGroup, err)
+		}
+
+		if results.Value != nil {
+			for _, set := range *results.Value {
+				resources = append(resources, sweepableResource{set.ID, set.Name, set.Location})
+			}
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "Availability Set", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMAvailabilitySet_basic(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +78,7 @@ func TestAccAzureRMAvailabilitySet_basic(t *testing.T) {
 
 func TestAccAzureRMAvailabilitySet_disappears(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +102,7 @@ func TestAccAzureRMAvailabilitySet_disappears(t *testing.T) {
 
 func TestAccAzureRMAvailabilitySet_withTags(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMAvailabilitySet_withTags(ri)
 	postConfig := testAccAzureRMAvailabilitySet_withUpdatedTags(ri)
 
@@ -90,7 +134,7 @@ func TestAccAzureRMAvailabilitySet_withTags(t *testing.T) {
 
 func TestAccAzureRMAvailabilitySet_withDomainCounts(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_withDomainCounts(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -112,7 +156,7 @@ func TestAccAzureRMAvailabilitySet_withDomainCounts(t *testing.T) {
 
 func TestAccAzureRMAvailabilitySet_managed(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMAvailabilitySet_managed(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -145,7 +189,7 @@ func testCheckAzureRMAvailabilitySetExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).availSetClient
+		conn := testAccProvider.Meta().(*ArmClient).availSetClient()
 
 		resp, err := conn.Get(resourceGroup, availSetName)
 		if err != nil {
@@ -174,7 +218,7 @@ func testCheckAzureRMAvailabilitySetDisappears(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).availSetClient
+		conn := testAccProvider.Meta().(*ArmClient).availSetClient()
 
 		_, err := conn.Delete(resourceGroup, availSetName)
 		if err != nil {
@@ -186,7 +230,7 @@ func testCheckAzureRMAvailabilitySetDisappears(name string) resource.TestCheckFu
 }
 
 func testCheckAzureRMAvailabilitySetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).availSetClient
+	conn := testAccProvider.Meta().(*ArmClient).availSetClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_availability_set" {
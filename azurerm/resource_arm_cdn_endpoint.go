@@ -2,10 +2,12 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -22,6 +24,12 @@ func resourceArmCdnEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -134,7 +142,10 @@ func resourceArmCdnEndpoint() *schema.Resource {
 
 func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	cdnEndpointsClient := client.cdnEndpointsClient
+	cdnEndpointsClient := client.cdnEndpointsClient()
+
+	ctx, cancel := context.WithTimeout(client.StopContext, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN EndPoint creation.")
 
@@ -146,7 +157,10 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 	https_allowed := d.Get("is_https_allowed").(bool)
 	compression_enabled := d.Get("is_compression_enabled").(bool)
 	caching_behaviour := d.Get("querystring_caching_behaviour").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	properties := cdn.EndpointProperties{
 		IsHTTPAllowed:              &http_allowed,
@@ -190,8 +204,8 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		Tags:               expandTags(tags),
 	}
 
-	_, error := cdnEndpointsClient.Create(resGroup, profileName, name, cdnEndpoint, make(<-chan struct{}))
-	err := <-error
+	_, error := cdnEndpointsClient.Create(resGroup, profileName, name, cdnEndpoint, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -210,18 +224,15 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	cdnEndpointsClient := meta.(*ArmClient).cdnEndpointsClient
+	cdnEndpointsClient := meta.(*ArmClient).cdnEndpointsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseCdnEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["endpoints"]
-	profileName := id.Path["profiles"]
-	if profileName == "" {
-		profileName = id.Path["Profiles"]
-	}
+	name := id.Name
+	profileName := id.ProfileName
 	log.Printf("[INFO] Trying to find the AzureRM CDN Endpoint %s (Profile: %s, RG: %s)", name, profileName, resGroup)
 	resp, err := cdnEndpointsClient.Get(resGroup, profileName, name)
 	if err != nil {
@@ -252,13 +263,16 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("origin", flattenAzureRMCdnEndpointOrigin(resp.EndpointProperties.Origins))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	cdnEndpointsClient := meta.(*ArmClient).cdnEndpointsClient
+	cdnEndpointsClient := meta.(*ArmClient).cdnEndpointsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -267,7 +281,10 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	https_allowed := d.Get("is_https_allowed").(bool)
 	compression_enabled := d.Get("is_compression_enabled").(bool)
 	caching_behaviour := d.Get("querystring_caching_behaviour").(string)
-	newTags := d.Get("tags").(map[string]interface{})
+	newTags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	properties := cdn.EndpointPropertiesUpdateParameters{
 		IsHTTPAllowed:              &http_allowed,
@@ -302,8 +319,8 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		EndpointPropertiesUpdateParameters: &properties,
 	}
 
-	_, error := cdnEndpointsClient.Update(resGroup, profileName, name, updateProps, make(<-chan struct{}))
-	err := <-error
+	_, error := cdnEndpointsClient.Update(resGroup, profileName, name, updateProps, ctx.Done())
+	err = <-error
 	if err != nil {
 		return fmt.Errorf("Error issuing Azure ARM update request to update CDN Endpoint %q: %s", name, err)
 	}
@@ -312,20 +329,20 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnEndpointsClient
+	client := meta.(*ArmClient).cdnEndpointsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseCdnEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	profileName := id.Path["profiles"]
-	if profileName == "" {
-		profileName = id.Path["Profiles"]
-	}
-	name := id.Path["endpoints"]
+	profileName := id.ProfileName
+	name := id.Name
 
-	accResp, error := client.Delete(resGroup, profileName, name, make(<-chan struct{}))
+	accResp, error := client.Delete(resGroup, profileName, name, ctx.Done())
 	resp := <-accResp
 	err = <-error
 	if err != nil {
@@ -5,14 +5,13 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMCdnEndpoint_basic(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnEndpoint_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMCdnEndpoint_basic(t *testing.T) {
 
 func TestAccAzureRMCdnEndpoint_disappears(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnEndpoint_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -54,7 +53,7 @@ func TestAccAzureRMCdnEndpoint_disappears(t *testing.T) {
 
 func TestAccAzureRMCdnEndpoint_updateHostHeader(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnEndpoint_hostHeader(ri, "www.example.com")
 	updatedConfig := testAccAzureRMCdnEndpoint_hostHeader(ri, "www.example2.com")
 
@@ -83,7 +82,7 @@ func TestAccAzureRMCdnEndpoint_updateHostHeader(t *testing.T) {
 
 func TestAccAzureRMCdnEndpoint_withTags(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMCdnEndpoint_withTags(ri)
 	postConfig := testAccAzureRMCdnEndpoint_withTagsUpdate(ri)
 
@@ -129,7 +128,7 @@ func testCheckAzureRMCdnEndpointExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for cdn endpoint: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient()
 
 		resp, err := conn.Get(resourceGroup, profileName, name)
 		if err != nil {
@@ -159,7 +158,7 @@ func testCheckAzureRMCdnEndpointDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for cdn endpoint: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient()
 
 		_, error := conn.Delete(resourceGroup, profileName, name, make(chan struct{}))
 		err := <-error
@@ -172,7 +171,7 @@ func testCheckAzureRMCdnEndpointDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMCdnEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient
+	conn := testAccProvider.Meta().(*ArmClient).cdnEndpointsClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_cdn_endpoint" {
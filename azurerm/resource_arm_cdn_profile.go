@@ -1,9 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +21,12 @@ func resourceArmCdnProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -49,7 +57,10 @@ func resourceArmCdnProfile() *schema.Resource {
 
 func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	cdnProfilesClient := client.cdnProfilesClient
+	cdnProfilesClient := client.cdnProfilesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN Profile creation.")
 
@@ -57,7 +68,10 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	sku := d.Get("sku").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
@@ -67,8 +81,8 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 		},
 	}
 
-	_, error := cdnProfilesClient.Create(resGroup, name, cdnProfile, make(chan struct{}))
-	err := <-error
+	_, error := cdnProfilesClient.Create(resGroup, name, cdnProfile, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -87,14 +101,14 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
-	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient
+	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseCdnProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["profiles"]
+	name := id.Name
 
 	resp, err := cdnProfilesClient.Get(resGroup, name)
 	if err != nil {
@@ -113,28 +127,34 @@ func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sku", string(resp.Sku.Name))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient
+	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient()
 
-	if !d.HasChange("tags") {
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if !d.HasChange("tags") && !d.HasChange("outdated_tags") {
 		return nil
 	}
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	newTags := d.Get("tags").(map[string]interface{})
+	newTags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	props := cdn.ProfileUpdateParameters{
 		Tags: expandTags(newTags),
 	}
 
-	_, error := cdnProfilesClient.Update(resGroup, name, props, make(chan struct{}))
-	err := <-error
+	_, error := cdnProfilesClient.Update(resGroup, name, props, ctx.Done())
+	err = <-error
 	if err != nil {
 		return fmt.Errorf("Error issuing Azure ARM update request to update CDN Profile %q: %s", name, err)
 	}
@@ -143,16 +163,19 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmCdnProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient
+	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseCdnProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["profiles"]
+	name := id.Name
 
-	_, error := cdnProfilesClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := cdnProfilesClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 	// TODO: check the status code
 
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -24,36 +23,32 @@ func testSweepCDNProfiles(region string) error {
 		return err
 	}
 
-	client := (*armClient).cdnProfilesClient
+	client := armClient.cdnProfilesClient()
+	resources := make([]sweepableResource, 0)
 
 	log.Printf("Retrieving the CDN Profiles..")
 	results, err := client.List()
-	if err != nil {
-		return fmt.Errorf("Error Listing on CDN Profiles: %+v", err)
-	}
-
-	for _, profile := range *results.Value {
-		if !shouldSweepAcceptanceTestResource(*profile.Name, *profile.Location, region) {
-			continue
-		}
-
-		resourceId, err := parseAzureResourceID(*profile.ID)
+	for {
 		if err != nil {
-			return err
+			return fmt.Errorf("Error listing CDN Profiles: %+v", err)
 		}
 
-		resourceGroup := resourceId.ResourceGroup
-		name := resourceId.Path["profiles"]
+		if results.Value != nil {
+			for _, profile := range *results.Value {
+				resources = append(resources, sweepableResource{profile.ID, profile.Name, profile.Location})
+			}
+		}
 
-		log.Printf("Deleting CDN Profile '%s' in Resource Group '%s'", name, resourceGroup)
-		_, error := client.Delete(resourceGroup, name, make(chan struct{}))
-		err = <-error
-		if err != nil {
-			return err
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
 		}
+		results, err = client.ListNextResults(results)
 	}
 
-	return nil
+	return sweepAcceptanceTestResources(region, "CDN Profile", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
 }
 
 func TestResourceAzureRMCdnProfileSKU_validation(t *testing.T) {
@@ -97,7 +92,7 @@ func TestResourceAzureRMCdnProfileSKU_validation(t *testing.T) {
 }
 
 func TestAccAzureRMCdnProfile_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnProfile_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -117,7 +112,7 @@ func TestAccAzureRMCdnProfile_basic(t *testing.T) {
 
 func TestAccAzureRMCdnProfile_withTags(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMCdnProfile_withTags(ri)
 	postConfig := testAccAzureRMCdnProfile_withTagsUpdate(ri)
 
@@ -154,7 +149,7 @@ func TestAccAzureRMCdnProfile_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMCdnProfile_NonStandardCasing(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCdnProfileNonStandardCasing(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -191,7 +186,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for cdn profile: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -207,7 +202,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMCdnProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_cdn_profile" {
@@ -1,18 +1,20 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"net/http"
 
 	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/arm/containerregistry"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmContainerRegistry() *schema.Resource {
@@ -24,6 +26,13 @@ func resourceArmContainerRegistry() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		MigrateState:  resourceAzureRMContainerRegistryMigrateState,
 		SchemaVersion: 1,
 
@@ -101,16 +110,22 @@ func resourceArmContainerRegistry() *schema.Resource {
 }
 
 func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerRegistryClient
+	client := meta.(*ArmClient).containerRegistryClient()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry creation.")
 
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	sku := d.Get("sku").(string)
 
 	adminUserEnabled := d.Get("admin_enabled").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := containerregistry.RegistryCreateParameters{
 		Location: &location,
@@ -129,12 +144,12 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 	storageAccountName := account["name"].(string)
 	storageAccountAccessKey := account["access_key"].(string)
 	parameters.RegistryPropertiesCreateParameters.StorageAccount = &containerregistry.StorageAccountParameters{
-		Name:      azure.String(storageAccountName),
-		AccessKey: azure.String(storageAccountAccessKey),
+		Name:      to.StringPtr(storageAccountName),
+		AccessKey: to.StringPtr(storageAccountAccessKey),
 	}
 
-	_, error := client.Create(resourceGroup, name, parameters, make(<-chan struct{}))
-	err := <-error
+	_, error := client.Create(resourceGroup, name, parameters, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -154,7 +169,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerRegistryClient
+	client := meta.(*ArmClient).containerRegistryClient()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry update.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -166,20 +181,23 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	storageAccountAccessKey := account["access_key"].(string)
 
 	adminUserEnabled := d.Get("admin_enabled").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := containerregistry.RegistryUpdateParameters{
 		RegistryPropertiesUpdateParameters: &containerregistry.RegistryPropertiesUpdateParameters{
 			AdminUserEnabled: &adminUserEnabled,
 			StorageAccount: &containerregistry.StorageAccountParameters{
-				Name:      azure.String(storageAccountName),
-				AccessKey: azure.String(storageAccountAccessKey),
+				Name:      to.StringPtr(storageAccountName),
+				AccessKey: to.StringPtr(storageAccountAccessKey),
 			},
 		},
 		Tags: expandTags(tags),
 	}
 
-	_, err := client.Update(resourceGroup, name, parameters)
+	_, err = client.Update(resourceGroup, name, parameters)
 	if err != nil {
 		return err
 	}
@@ -199,14 +217,14 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerRegistryClient
+	client := meta.(*ArmClient).containerRegistryClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseContainerRegistryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name := id.Name
 
 	resp, err := client.Get(resourceGroup, name)
 	if err != nil {
@@ -248,20 +266,20 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 		d.Set("admin_password", "")
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerRegistryClient
+	client := meta.(*ArmClient).containerRegistryClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseContainerRegistryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name := id.Name
 
 	resp, err := client.Delete(resourceGroup, name)
 
@@ -2,14 +2,58 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_container_registry", &resource.Sweeper{
+		Name: "azurerm_container_registry",
+		F:    testSweepContainerRegistries,
+		Dependencies: []string{
+			"azurerm_container_service",
+		},
+	})
+}
+
+func testSweepContainerRegistries(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.containerRegistryClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Container Registries..")
+	results, err := client.List()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Container Registries: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, registry := range *results.Value {
+				resources = append(resources, sweepableResource{registry.ID, registry.Name, registry.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Container Registry", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMContainerRegistryName_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -67,8 +111,8 @@ func TestAccAzureRMContainerRegistryName_validation(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_basic(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_basic(ri, rs)
 
 	resource.Test(t, resource.TestCase{
@@ -87,8 +131,8 @@ func TestAccAzureRMContainerRegistry_basic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_complete(ri, rs)
 
 	resource.Test(t, resource.TestCase{
@@ -107,8 +151,8 @@ func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_update(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_complete(ri, rs)
 	updatedConfig := testAccAzureRMContainerRegistry_completeUpdated(ri, rs)
 
@@ -134,7 +178,7 @@ func TestAccAzureRMContainerRegistry_update(t *testing.T) {
 }
 
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+	conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_container_registry" {
@@ -172,7 +216,7 @@ func testCheckAzureRMContainerRegistryExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+		conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -22,6 +23,14 @@ func resourceArmContainerService() *schema.Resource {
 		Read:   resourceArmContainerServiceRead,
 		Update: resourceArmContainerServiceCreate,
 		Delete: resourceArmContainerServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -187,7 +196,10 @@ func resourceArmContainerService() *schema.Resource {
 
 func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	containerServiceClient := client.containerServicesClient
+	containerServiceClient := client.containerServicesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Container Service creation.")
 
@@ -202,7 +214,10 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 	agentProfiles := expandAzureRmContainerServiceAgentProfiles(d)
 	diagnosticsProfile := expandAzureRmContainerServiceDiagnostics(d)
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := containerservice.ContainerService{
 		Name:     &name,
@@ -224,8 +239,8 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		parameters.ServicePrincipalProfile = servicePrincipalProfile
 	}
 
-	_, error := containerServiceClient.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
-	err := <-error
+	_, error := containerServiceClient.CreateOrUpdate(resGroup, name, parameters, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -244,7 +259,7 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    containerServiceStateRefreshFunc(client, resGroup, name),
-		Timeout:    30 * time.Minute,
+		Timeout:    createOrUpdateTimeout(d),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -257,14 +272,14 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) error {
-	containerServiceClient := meta.(*ArmClient).containerServicesClient
+	containerServiceClient := meta.(*ArmClient).containerServicesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseContainerServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["containerServices"]
+	name := id.Name
 
 	resp, err := containerServiceClient.Get(resGroup, name)
 	if err != nil {
@@ -301,23 +316,26 @@ func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("diagnostics_profile", diagnosticProfile)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	containerServiceClient := client.containerServicesClient
+	containerServiceClient := client.containerServicesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseContainerServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["containerServices"]
+	name := id.Name
 
-	delResp, error := containerServiceClient.Delete(resGroup, name, make(chan struct{}))
+	delResp, error := containerServiceClient.Delete(resGroup, name, ctx.Done())
 	resp := <-delResp
 	err = <-error
 	if err != nil {
@@ -533,7 +551,7 @@ func expandAzureRmContainerServiceAgentProfiles(d *schema.ResourceData) []contai
 
 func containerServiceStateRefreshFunc(client *ArmClient, resourceGroupName string, containerServiceName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.containerServicesClient.Get(resourceGroupName, containerServiceName)
+		res, err := client.containerServicesClient().Get(resourceGroupName, containerServiceName)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in containerServiceStateRefreshFunc to Azure ARM for Container Service '%s' (RG: '%s'): %s", containerServiceName, resourceGroupName, err)
 		}
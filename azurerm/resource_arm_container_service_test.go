@@ -2,15 +2,56 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_container_service", &resource.Sweeper{
+		Name: "azurerm_container_service",
+		F:    testSweepContainerServices,
+	})
+}
+
+func testSweepContainerServices(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.containerServicesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Container Services..")
+	results, err := client.List()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Container Services: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, service := range *results.Value {
+				resources = append(resources, sweepableResource{service.ID, service.Name, service.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Container Service", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMContainerService_orchestrationPlatformValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -77,7 +118,7 @@ func TestAccAzureRMContainerService_agentProfilePoolCountValidation(t *testing.T
 }
 
 func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := fmt.Sprintf(testAccAzureRMContainerService_dcosBasic, ri, ri, ri, ri, ri)
 
 	resource.Test(t, resource.TestCase{
@@ -96,7 +137,7 @@ func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMContainerService_kubernetesBasic(ri, clientId, clientSecret)
@@ -117,7 +158,7 @@ func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMContainerService_kubernetesComplete(ri, clientId, clientSecret)
@@ -138,7 +179,7 @@ func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_swarmBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := fmt.Sprintf(testAccAzureRMContainerService_swarmBasic, ri, ri, ri, ri, ri)
 
 	resource.Test(t, resource.TestCase{
@@ -340,7 +381,7 @@ func testCheckAzureRMContainerServiceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Container Service Instance: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerServicesClient
+		conn := testAccProvider.Meta().(*ArmClient).containerServicesClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -356,7 +397,7 @@ func testCheckAzureRMContainerServiceExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMContainerServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerServicesClient
+	conn := testAccProvider.Meta().(*ArmClient).containerServicesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_container_service" {
@@ -2,10 +2,12 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/cosmos-db"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -23,6 +25,12 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -145,9 +153,12 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 }
 
 func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
+	client := meta.(*ArmClient).cosmosDBClient()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account creation.")
 
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
+
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -159,7 +170,10 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := cosmosdb.DatabaseAccountCreateUpdateParameters{
 		Location: &location,
@@ -172,7 +186,7 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 		Tags: expandTags(tags),
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	_, error := client.CreateOrUpdate(resGroup, name, parameters, ctx.Done())
 	err = <-error
 	if err != nil {
 		return err
@@ -193,13 +207,13 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	id, err := parseAzureResourceID(d.Id())
+	client := meta.(*ArmClient).cosmosDBClient()
+	id, err := parseCosmosDBAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["databaseAccounts"]
+	name := id.Name
 
 	resp, err := client.Get(resGroup, name)
 	if err != nil {
@@ -235,22 +249,25 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("secondary_readonly_master_key", readonlyKeys.SecondaryReadonlyMasterKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
+	client := meta.(*ArmClient).cosmosDBClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseCosmosDBAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["databaseAccounts"]
+	name := id.Name
 
-	deleteResp, error := client.Delete(resGroup, name, make(chan struct{}))
+	deleteResp, error := client.Delete(resGroup, name, ctx.Done())
 	resp := <-deleteResp
 	err = <-error
 
@@ -2,16 +2,50 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_cosmosdb_account", &resource.Sweeper{
+		Name: "azurerm_cosmosdb_account",
+		F:    testSweepCosmosDBAccounts,
+	})
+}
+
+func testSweepCosmosDBAccounts(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.cosmosDBClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the CosmosDB Accounts..")
+	results, err := client.List()
+	if err != nil {
+		return fmt.Errorf("Error listing CosmosDB Accounts: %+v", err)
+	}
+
+	if results.Value != nil {
+		for _, account := range *results.Value {
+			resources = append(resources, sweepableResource{account.ID, account.Name, account.Location})
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "CosmosDB Account", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMCosmosDBAccountName_validation(t *testing.T) {
-	str := acctest.RandString(50)
+	str := acctRandString(t, 50)
 	cases := []struct {
 		Value    string
 		ErrCount int
@@ -45,7 +79,7 @@ func TestAccAzureRMCosmosDBAccountName_validation(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStaleness(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -65,7 +99,7 @@ func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_boundedStalenessComplete(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStalenessComplete(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -84,7 +118,7 @@ func TestAccAzureRMCosmosDBAccount_boundedStalenessComplete(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_eventualConsistency(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_eventualConsistency(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -103,7 +137,7 @@ func TestAccAzureRMCosmosDBAccount_eventualConsistency(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_session(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_session(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -122,7 +156,7 @@ func TestAccAzureRMCosmosDBAccount_session(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_strong(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_strong(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -142,7 +176,7 @@ func TestAccAzureRMCosmosDBAccount_strong(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_geoReplicated(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -161,7 +195,7 @@ func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
 }
 
 func testCheckAzureRMCosmosDBAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient
+	conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_cosmos_db" {
@@ -199,7 +233,7 @@ func testCheckAzureRMCosmosDBAccountExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for CosmosDB Account: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient
+		conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
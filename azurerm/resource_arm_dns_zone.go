@@ -1,20 +1,29 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/dns"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func resourceArmDnsZone() *schema.Resource {
-	return &schema.Resource{
+	return withSubscriptionOverride(&schema.Resource{
 		Create: resourceArmDnsZoneCreate,
 		Read:   resourceArmDnsZoneRead,
 		Update: resourceArmDnsZoneCreate,
 		Delete: resourceArmDnsZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmDnsZoneImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,17 +59,20 @@ func resourceArmDnsZone() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func resourceArmDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).zonesClient
+	client := meta.(*ArmClient).zonesClient()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	location := "global"
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := dns.Zone{
 		Location: &location,
@@ -84,15 +96,15 @@ func resourceArmDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	zonesClient := meta.(*ArmClient).zonesClient
+	zonesClient := meta.(*ArmClient).zonesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseDnsZoneID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["dnszones"]
+	name := id.Name
 
 	resp, err := zonesClient.Get(resGroup, name)
 	if err != nil {
@@ -108,32 +120,108 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("number_of_record_sets", resp.NumberOfRecordSets)
 	d.Set("max_number_of_record_sets", resp.MaxNumberOfRecordSets)
 
-	nameServers := make([]string, 0, len(*resp.NameServers))
-	for _, ns := range *resp.NameServers {
-		nameServers = append(nameServers, ns)
+	nameServers := make([]string, 0)
+	if resp.NameServers != nil {
+		for _, ns := range *resp.NameServers {
+			nameServers = append(nameServers, ns)
+		}
 	}
 	if err := d.Set("name_servers", nameServers); err != nil {
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
+// dnsRecordResources are the resources used to manage each type of DNS Record Set
+var dnsRecordResources = map[string]struct {
+	resourceType string
+	resource     func() *schema.Resource
+}{
+	"A":     {"azurerm_dns_a_record", resourceArmDnsARecord},
+	"AAAA":  {"azurerm_dns_aaaa_record", resourceArmDnsAAAARecord},
+	"CNAME": {"azurerm_dns_cname_record", resourceArmDnsCNameRecord},
+	"MX":    {"azurerm_dns_mx_record", resourceArmDnsMxRecord},
+	"NS":    {"azurerm_dns_ns_record", resourceArmDnsNsRecord},
+	"PTR":   {"azurerm_dns_ptr_record", resourceArmDnsPtrRecord},
+	"SRV":   {"azurerm_dns_srv_record", resourceArmDnsSrvRecord},
+	"TXT":   {"azurerm_dns_txt_record", resourceArmDnsTxtRecord},
+}
+
+// resourceArmDnsZoneImportState imports the DNS Zone, along with each of the Record Sets within it as the
+// `azurerm_dns_*_record` resources. The SOA Record and the NS Records at the apex of the zone are managed
+// by Azure, and so aren't imported.
+func resourceArmDnsZoneImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ArmClient).dnsClient()
+
+	id, err := parseDnsZoneID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*schema.ResourceData{d}
+
+	resp, err := client.ListByDNSZone(id.ResourceGroup, id.Name, nil)
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the Record Sets in DNS Zone %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if resp.Value != nil {
+			for _, recordSet := range *resp.Value {
+				if recordSet.Name == nil || recordSet.Type == nil {
+					continue
+				}
+
+				// the Type is in the form `Microsoft.Network/dnszones/A`
+				recordType := strings.ToUpper((*recordSet.Type)[strings.LastIndex(*recordSet.Type, "/")+1:])
+				record, ok := dnsRecordResources[recordType]
+				if !ok || (recordType == "NS" && *recordSet.Name == "@") {
+					continue
+				}
+
+				recordId := DnsRecordID{
+					SubscriptionID: id.SubscriptionID,
+					ResourceGroup:  id.ResourceGroup,
+					ZoneName:       id.Name,
+					RecordType:     recordType,
+					Name:           *recordSet.Name,
+				}
+
+				child := record.resource().Data(nil)
+				child.SetId(recordId.String())
+				child.SetType(record.resourceType)
+				results = append(results, child)
+			}
+		}
+
+		if resp.NextLink == nil || *resp.NextLink == "" {
+			break
+		}
+		resp, err = client.ListByDNSZoneNextResults(resp)
+	}
+
+	return results, nil
+}
+
 func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).zonesClient
+	client := meta.(*ArmClient).zonesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseDnsZoneID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["dnszones"]
+	name := id.Name
 
 	etag := ""
-	_, error := client.Delete(resGroup, name, etag, make(chan struct{}))
+	_, error := client.Delete(resGroup, name, etag, ctx.Done())
 	err = <-error
 
 	if err != nil {
@@ -2,17 +2,58 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_dns_zone", &resource.Sweeper{
+		Name: "azurerm_dns_zone",
+		F:    testSweepDNSZones,
+	})
+}
+
+func testSweepDNSZones(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.zonesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the DNS Zones..")
+	results, err := client.List(nil)
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing DNS Zones: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, zone := range *results.Value {
+				resources = append(resources, sweepableResource{zone.ID, zone.Name, zone.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "DNS Zone", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, "", make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMDnsZone_basic(t *testing.T) {
 	resourceName := "azurerm_dns_zone.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMDnsZone_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +73,7 @@ func TestAccAzureRMDnsZone_basic(t *testing.T) {
 
 func TestAccAzureRMDnsZone_withTags(t *testing.T) {
 	resourceName := "azurerm_dns_zone.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMDnsZone_withTags(ri, location)
 	postConfig := testAccAzureRMDnsZone_withTagsUupdate(ri, location)
@@ -60,6 +101,61 @@ func TestAccAzureRMDnsZone_withTags(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMDnsZone_fakeArmImportRecordSets(t *testing.T) {
+	resourceName := "azurerm_dns_zone.test"
+	ri := acctRandInt(t)
+	config := testAccAzureRMDnsARecord_basic(ri, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	zoneId := DnsZoneID{
+		SubscriptionID: fakeArmSubscriptionID,
+		ResourceGroup:  fmt.Sprintf("acctestRG_%d", ri),
+		Name:           fmt.Sprintf("acctestzone%d.com", ri),
+	}
+	recordId := DnsRecordID{
+		SubscriptionID: zoneId.SubscriptionID,
+		ResourceGroup:  zoneId.ResourceGroup,
+		ZoneName:       zoneId.Name,
+		RecordType:     "A",
+		Name:           fmt.Sprintf("myarecord%d", ri),
+	}
+
+	// the SOA & NS Records at the apex of the zone are managed by Azure
+	apexRecords := []DnsRecordID{
+		{zoneId.SubscriptionID, zoneId.ResourceGroup, zoneId.Name, "SOA", "@"},
+		{zoneId.SubscriptionID, zoneId.ResourceGroup, zoneId.Name, "NS", "@"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_dns_zone"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  server.checkExists("azurerm_dns_a_record.test"),
+			},
+			{
+				PreConfig: func() {
+					for _, id := range apexRecords {
+						server.put(id.String(), map[string]interface{}{
+							"id":         id.String(),
+							"name":       id.Name,
+							"type":       "Microsoft.Network/dnszones/" + id.RecordType,
+							"properties": map[string]interface{}{},
+						})
+					}
+				},
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateCheck: server.checkImported(zoneId.String(), recordId.String()),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDnsZoneExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -74,7 +170,7 @@ func testCheckAzureRMDnsZoneExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for DNS zone: %s", zoneName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).zonesClient
+		client := testAccProvider.Meta().(*ArmClient).zonesClient()
 		resp, err := client.Get(resourceGroup, zoneName)
 		if err != nil {
 			return fmt.Errorf("Bad: Get DNS zone: %+v", err)
@@ -89,7 +185,7 @@ func testCheckAzureRMDnsZoneExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDnsZoneDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).zonesClient
+	conn := testAccProvider.Meta().(*ArmClient).zonesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_dns_zone" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/eventhub"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,12 @@ func resourceArmEventHub() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -64,7 +71,7 @@ func resourceArmEventHub() *schema.Resource {
 
 func resourceArmEventHubCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	eventhubClient := client.eventHubClient
+	eventhubClient := client.eventHubClient()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub creation.")
 
 	name := d.Get("name").(string)
@@ -102,15 +109,15 @@ func resourceArmEventHubCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*ArmClient).eventHubClient
+	eventhubClient := meta.(*ArmClient).eventHubClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := eventhubClient.Get(resGroup, namespaceName, name)
 	if err != nil {
@@ -134,15 +141,15 @@ func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*ArmClient).eventHubClient
+	eventhubClient := meta.(*ArmClient).eventHubClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := eventhubClient.Delete(resGroup, namespaceName, name)
 
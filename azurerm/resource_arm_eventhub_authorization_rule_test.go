@@ -5,13 +5,12 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMEventHubAuthorizationRule_listen(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_listen(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -30,7 +29,7 @@ func TestAccAzureRMEventHubAuthorizationRule_listen(t *testing.T) {
 }
 
 func TestAccAzureRMEventHubAuthorizationRule_send(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_send(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccAzureRMEventHubAuthorizationRule_send(t *testing.T) {
 }
 
 func TestAccAzureRMEventHubAuthorizationRule_readwrite(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_readWrite(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -68,7 +67,7 @@ func TestAccAzureRMEventHubAuthorizationRule_readwrite(t *testing.T) {
 }
 
 func TestAccAzureRMEventHubAuthorizationRule_manage(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubAuthorizationRule_manage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -87,7 +86,7 @@ func TestAccAzureRMEventHubAuthorizationRule_manage(t *testing.T) {
 }
 
 func testCheckAzureRMEventHubAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventHubClient
+	conn := testAccProvider.Meta().(*ArmClient).eventHubClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_eventhub_authorization_rule" {
@@ -128,7 +127,7 @@ func testCheckAzureRMEventHubAuthorizationRuleExists(name string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventHubClient
+		conn := testAccProvider.Meta().(*ArmClient).eventHubClient()
 		resp, err := conn.GetAuthorizationRule(resourceGroup, namespaceName, eventHubName, name)
 		if err != nil {
 			return fmt.Errorf("Bad: Get on eventHubClient: %s", err)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/eventhub"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,12 @@ func resourceArmEventHubConsumerGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -56,7 +63,7 @@ func resourceArmEventHubConsumerGroup() *schema.Resource {
 
 func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	eventhubClient := client.eventHubConsumerGroupClient
+	eventhubClient := client.eventHubConsumerGroupClient()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Consumer Group creation.")
 
 	name := d.Get("name").(string)
@@ -95,16 +102,16 @@ func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*ArmClient).eventHubConsumerGroupClient
+	eventhubClient := meta.(*ArmClient).eventHubConsumerGroupClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName := id.NamespaceName
+	eventHubName := id.EventHubName
+	name := id.Name
 
 	resp, err := eventhubClient.Get(resGroup, namespaceName, eventHubName, name)
 	if err != nil {
@@ -126,16 +133,16 @@ func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmEventHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*ArmClient).eventHubConsumerGroupClient
+	eventhubClient := meta.(*ArmClient).eventHubConsumerGroupClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName := id.NamespaceName
+	eventHubName := id.EventHubName
+	name := id.Name
 
 	resp, err := eventhubClient.Delete(resGroup, namespaceName, eventHubName, name)
 
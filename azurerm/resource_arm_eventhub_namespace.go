@@ -1,9 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"net/http"
 
@@ -25,6 +27,12 @@ func resourceArmEventHubNamespace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -81,15 +89,21 @@ func resourceArmEventHubNamespace() *schema.Resource {
 
 func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	namespaceClient := client.eventHubNamespacesClient
+	namespaceClient := client.eventHubNamespacesClient()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub Namespace creation.")
 
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
+
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	sku := d.Get("sku").(string)
 	capacity := int32(d.Get("capacity").(int))
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	parameters := eventhub.NamespaceCreateOrUpdateParameters{
 		Location: &location,
@@ -101,8 +115,8 @@ func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}
 		Tags: expandTags(tags),
 	}
 
-	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
-	err := <-error
+	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -122,14 +136,14 @@ func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	namespaceClient := meta.(*ArmClient).eventHubNamespacesClient
+	namespaceClient := meta.(*ArmClient).eventHubNamespacesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubNamespaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name := id.Name
 
 	resp, err := namespaceClient.Get(resGroup, name)
 	if err != nil {
@@ -156,22 +170,25 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	namespaceClient := meta.(*ArmClient).eventHubNamespacesClient
+	namespaceClient := meta.(*ArmClient).eventHubNamespacesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseEventHubNamespaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name := id.Name
 
-	deleteResp, error := namespaceClient.Delete(resGroup, name, make(chan struct{}))
+	deleteResp, error := namespaceClient.Delete(resGroup, name, ctx.Done())
 	resp := <-deleteResp
 	err = <-error
 
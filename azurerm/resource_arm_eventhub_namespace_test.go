@@ -2,15 +2,56 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_eventhub_namespace", &resource.Sweeper{
+		Name: "azurerm_eventhub_namespace",
+		F:    testSweepEventHubNamespaces,
+	})
+}
+
+func testSweepEventHubNamespaces(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.eventHubNamespacesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the EventHub Namespaces..")
+	results, err := client.ListBySubscription()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing EventHub Namespaces: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, namespace := range *results.Value {
+				resources = append(resources, sweepableResource{namespace.ID, namespace.Name, namespace.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListBySubscriptionNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "EventHub Namespace", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMEventHubNamespaceCapacity_validation(t *testing.T) {
 	cases := []struct {
 		Value    int
@@ -81,7 +122,7 @@ func TestAccAzureRMEventHubNamespaceSku_validation(t *testing.T) {
 
 func TestAccAzureRMEventHubNamespace_basic(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubNamespace_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -101,7 +142,7 @@ func TestAccAzureRMEventHubNamespace_basic(t *testing.T) {
 
 func TestAccAzureRMEventHubNamespace_standard(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubNamespace_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -121,7 +162,7 @@ func TestAccAzureRMEventHubNamespace_standard(t *testing.T) {
 
 func TestAccAzureRMEventHubNamespace_readDefaultKeys(t *testing.T) {
 	resourceName := "azurerm_eventhub_namespace.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubNamespace_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -145,7 +186,7 @@ func TestAccAzureRMEventHubNamespace_readDefaultKeys(t *testing.T) {
 
 func TestAccAzureRMEventHubNamespace_NonStandardCasing(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMEventHubNamespaceNonStandardCasing(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -169,7 +210,7 @@ func TestAccAzureRMEventHubNamespace_NonStandardCasing(t *testing.T) {
 }
 
 func testCheckAzureRMEventHubNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventHubNamespacesClient
+	conn := testAccProvider.Meta().(*ArmClient).eventHubNamespacesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_eventhub_namespace" {
@@ -207,7 +248,7 @@ func testCheckAzureRMEventHubNamespaceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub Namespace: %s", namespaceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventHubNamespacesClient
+		conn := testAccProvider.Meta().(*ArmClient).eventHubNamespacesClient()
 
 		resp, err := conn.Get(resourceGroup, namespaceName)
 		if err != nil {
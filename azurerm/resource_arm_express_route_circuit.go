@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"log"
 	"strings"
+	"time"
 
 	"fmt"
 
@@ -24,6 +26,12 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -112,7 +120,10 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 
 func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	ercClient := client.expressRouteCircuitClient
+	ercClient := client.expressRouteCircuitClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRouteCircuit creation.")
 
@@ -124,7 +135,10 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 	bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	erc := network.ExpressRouteCircuit{
@@ -142,8 +156,8 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 		Tags: expandedTags,
 	}
 
-	_, error := ercClient.CreateOrUpdate(resGroup, name, erc, make(chan struct{}))
-	err := <-error
+	_, error := ercClient.CreateOrUpdate(resGroup, name, erc, ctx.Done())
+	err = <-error
 	if err != nil {
 		return errwrap.Wrapf("Error Creating/Updating ExpressRouteCircuit {{err}}", err)
 	}
@@ -191,20 +205,23 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 	d.Set("service_key", erc.ServiceKey)
 	d.Set("allow_classic_operations", erc.AllowClassicOperations)
 
-	flattenAndSetTags(d, erc.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, erc.Tags))
 
 	return nil
 }
 
 func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
-	ercClient := meta.(*ArmClient).expressRouteCircuitClient
+	ercClient := meta.(*ArmClient).expressRouteCircuitClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	resGroup, name, err := extractResourceGroupAndErcName(d.Id())
 	if err != nil {
 		return errwrap.Wrapf("Error Parsing Azure Resource ID {{err}}", err)
 	}
 
-	_, error := ercClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := ercClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 	return err
 }
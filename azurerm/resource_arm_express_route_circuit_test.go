@@ -2,18 +2,59 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_express_route_circuit", &resource.Sweeper{
+		Name: "azurerm_express_route_circuit",
+		F:    testSweepExpressRouteCircuits,
+	})
+}
+
+func testSweepExpressRouteCircuits(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.expressRouteCircuitClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the ExpressRoute Circuits..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing ExpressRoute Circuits: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, circuit := range *results.Value {
+				resources = append(resources, sweepableResource{circuit.ID, circuit.Name, circuit.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "ExpressRoute Circuit", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMExpressRouteCircuit_basic(t *testing.T) {
 	var erc network.ExpressRouteCircuit
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -43,7 +84,7 @@ func testCheckAzureRMExpressRouteCircuitExists(name string, erc *network.Express
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit: %s", expressRouteCircuitName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+		conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient()
 
 		resp, err := conn.Get(resourceGroup, expressRouteCircuitName)
 		if err != nil {
@@ -61,7 +102,7 @@ func testCheckAzureRMExpressRouteCircuitExists(name string, erc *network.Express
 }
 
 func testCheckAzureRMExpressRouteCircuitDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+	conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_express_route_circuit" {
@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +21,12 @@ func resourceArmImage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -152,14 +160,20 @@ func resourceArmImage() *schema.Resource {
 
 func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	imageClient := client.imageClient
+	imageClient := client.imageClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Image creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 	properties := compute.ImageProperties{}
 
@@ -210,7 +224,7 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		ImageProperties: &properties,
 	}
 
-	_, imageErr := imageClient.CreateOrUpdate(resGroup, name, createImage, make(chan struct{}))
+	_, imageErr := imageClient.CreateOrUpdate(resGroup, name, createImage, ctx.Done())
 	err = <-imageErr
 	if err != nil {
 		return err
@@ -230,14 +244,14 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
-	imageClient := meta.(*ArmClient).imageClient
+	imageClient := meta.(*ArmClient).imageClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseImageID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name := id.Name
 
 	resp, err := imageClient.Get(resGroup, name, "")
 	if err != nil {
@@ -267,22 +281,25 @@ func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
-	imageClient := meta.(*ArmClient).imageClient
+	imageClient := meta.(*ArmClient).imageClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseImageID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name := id.Name
 
-	_, deleteErr := imageClient.Delete(resGroup, name, make(chan struct{}))
+	_, deleteErr := imageClient.Delete(resGroup, name, ctx.Done())
 	err = <-deleteErr
 	if err != nil {
 		return err
@@ -2,16 +2,57 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_key_vault", &resource.Sweeper{
+		Name: "azurerm_key_vault",
+		F:    testSweepKeyVaults,
+	})
+}
+
+func testSweepKeyVaults(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.keyVaultClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Key Vaults..")
+	results, err := client.List("resourceType eq 'Microsoft.KeyVault/vaults'", nil)
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Key Vaults: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, vault := range *results.Value {
+				resources = append(resources, sweepableResource{vault.ID, vault.Name, vault.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Key Vault", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMKeyVault_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMKeyVault_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -30,7 +71,7 @@ func TestAccAzureRMKeyVault_basic(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVault_update(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	resourceName := "azurerm_key_vault.test"
 	preConfig := testAccAzureRMKeyVault_basic(ri, testLocation())
 	postConfig := testAccAzureRMKeyVault_update(ri, testLocation())
@@ -65,7 +106,7 @@ func TestAccAzureRMKeyVault_update(t *testing.T) {
 }
 
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+	client := testAccProvider.Meta().(*ArmClient).keyVaultClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_key_vault" {
@@ -105,7 +146,7 @@ func testCheckAzureRMKeyVaultExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for vault: %s", vaultName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient()
 
 		resp, err := client.Get(resourceGroup, vaultName)
 		if err != nil {
@@ -1,15 +1,16 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmLoadBalancer() *schema.Resource {
@@ -19,7 +20,13 @@ func resourceArmLoadBalancer() *schema.Resource {
 		Update: resourceArmLoadBalancerCreate,
 		Delete: resourceArmLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmLoadBalancerImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,14 +111,20 @@ func resourceArmLoadBalancer() *schema.Resource {
 
 func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	loadBalancerClient := client.loadBalancerClient
+	loadBalancerClient := client.loadBalancerClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM LoadBalancer creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	properties := network.LoadBalancerPropertiesFormat{}
@@ -121,14 +134,14 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	loadbalancer := network.LoadBalancer{
-		Name:     azure.String(name),
-		Location: azure.String(location),
+		Name:     to.StringPtr(name),
+		Location: to.StringPtr(location),
 		Tags:     expandedTags,
 		LoadBalancerPropertiesFormat: &properties,
 	}
 
-	_, error := loadBalancerClient.CreateOrUpdate(resGroup, name, loadbalancer, make(chan struct{}))
-	err := <-error
+	_, error := loadBalancerClient.CreateOrUpdate(resGroup, name, loadbalancer, ctx.Done())
+	err = <-error
 	if err != nil {
 		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
 	}
@@ -148,7 +161,7 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		Pending: []string{"Accepted", "Updating"},
 		Target:  []string{"Succeeded"},
 		Refresh: loadbalancerStateRefreshFunc(client, resGroup, name),
-		Timeout: 10 * time.Minute,
+		Timeout: createOrUpdateTimeout(d),
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", name, err)
@@ -158,7 +171,7 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourecArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseLoadBalancerID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -191,22 +204,141 @@ func resourecArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	flattenAndSetTags(d, loadBalancer.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, loadBalancer.Tags))
 
 	return nil
 }
 
+// resourceArmLoadBalancerImportState imports the Load Balancer, along with each of its Backend Address Pools,
+// Probes, Rules, NAT Rules and NAT Pools as the `azurerm_lb_*` resources.
+func resourceArmLoadBalancerImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := parseLoadBalancerID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	if err != nil {
+		return nil, errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("LoadBalancer %q (Resource Group %q) was not found", id.Name, id.ResourceGroup)
+	}
+
+	results := []*schema.ResourceData{d}
+	props := loadBalancer.LoadBalancerPropertiesFormat
+	if props == nil {
+		return results, nil
+	}
+
+	children := make([]*schema.ResourceData, 0)
+	addChild := func(resourceType string, r *schema.Resource, childId string) {
+		child := r.Data(nil)
+		child.SetId(childId)
+		child.SetType(resourceType)
+		children = append(children, child)
+	}
+
+	if props.BackendAddressPools != nil {
+		for _, pool := range *props.BackendAddressPools {
+			if pool.Name == nil {
+				continue
+			}
+			poolId := LoadBalancerBackendAddressPoolID{
+				SubscriptionID:   id.SubscriptionID,
+				ResourceGroup:    id.ResourceGroup,
+				LoadBalancerName: id.Name,
+				Name:             *pool.Name,
+			}
+			addChild("azurerm_lb_backend_address_pool", resourceArmLoadBalancerBackendAddressPool(), poolId.String())
+		}
+	}
+
+	if props.Probes != nil {
+		for _, probe := range *props.Probes {
+			if probe.Name == nil {
+				continue
+			}
+			probeId := LoadBalancerProbeID{
+				SubscriptionID:   id.SubscriptionID,
+				ResourceGroup:    id.ResourceGroup,
+				LoadBalancerName: id.Name,
+				Name:             *probe.Name,
+			}
+			addChild("azurerm_lb_probe", resourceArmLoadBalancerProbe(), probeId.String())
+		}
+	}
+
+	if props.LoadBalancingRules != nil {
+		for _, rule := range *props.LoadBalancingRules {
+			if rule.Name == nil {
+				continue
+			}
+			ruleId := LoadBalancerRuleID{
+				SubscriptionID:   id.SubscriptionID,
+				ResourceGroup:    id.ResourceGroup,
+				LoadBalancerName: id.Name,
+				Name:             *rule.Name,
+			}
+			addChild("azurerm_lb_rule", resourceArmLoadBalancerRule(), ruleId.String())
+		}
+	}
+
+	if props.InboundNatRules != nil {
+		for _, rule := range *props.InboundNatRules {
+			if rule.Name == nil {
+				continue
+			}
+
+			ruleId := LoadBalancerInboundNatRuleID{
+				SubscriptionID:   id.SubscriptionID,
+				ResourceGroup:    id.ResourceGroup,
+				LoadBalancerName: id.Name,
+				Name:             *rule.Name,
+			}
+			addChild("azurerm_lb_nat_rule", resourceArmLoadBalancerNatRule(), ruleId.String())
+		}
+	}
+
+	if props.InboundNatPools != nil {
+		for _, pool := range *props.InboundNatPools {
+			if pool.Name == nil {
+				continue
+			}
+			poolId := LoadBalancerInboundNatPoolID{
+				SubscriptionID:   id.SubscriptionID,
+				ResourceGroup:    id.ResourceGroup,
+				LoadBalancerName: id.Name,
+				Name:             *pool.Name,
+			}
+			addChild("azurerm_lb_nat_pool", resourceArmLoadBalancerNatPool(), poolId.String())
+		}
+	}
+
+	// the child resources reference the Load Balancer through `loadbalancer_id`
+	for _, child := range children {
+		if _, err := loadBalancerSubResourceStateImporter(child, meta); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(results, children...), nil
+}
+
 func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
-	loadBalancerClient := meta.(*ArmClient).loadBalancerClient
+	loadBalancerClient := meta.(*ArmClient).loadBalancerClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseLoadBalancerID(d.Id())
 	if err != nil {
 		return errwrap.Wrapf("Error Parsing Azure Resource ID {{err}}", err)
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["loadBalancers"]
+	name := id.Name
 
-	_, error := loadBalancerClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := loadBalancerClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 	if err != nil {
 		return errwrap.Wrapf("Error Deleting LoadBalancer {{err}}", err)
@@ -69,8 +69,8 @@ func TestResourceAzureRMLoadBalancerRuleNameLabel_validation(t *testing.T) {
 
 func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	lbRule_id := fmt.Sprintf(
@@ -97,8 +97,8 @@ func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 
 func TestAccAzureRMLoadBalancerRule_removal(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -126,10 +126,10 @@ func TestAccAzureRMLoadBalancerRule_removal(t *testing.T) {
 // https://github.com/hashicorp/terraform/issues/9424
 func TestAccAzureRMLoadBalancerRule_inconsistentReads(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	backendPoolName := fmt.Sprintf("LbPool-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	probeName := fmt.Sprintf("LbProbe-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	backendPoolName := fmt.Sprintf("LbPool-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
+	probeName := fmt.Sprintf("LbProbe-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -151,9 +151,9 @@ func TestAccAzureRMLoadBalancerRule_inconsistentReads(t *testing.T) {
 
 func TestAccAzureRMLoadBalancerRule_update(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	lbRule2Name := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
+	lbRule2Name := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	lbRuleID := fmt.Sprintf(
@@ -199,8 +199,8 @@ func TestAccAzureRMLoadBalancerRule_update(t *testing.T) {
 
 func TestAccAzureRMLoadBalancerRule_reapply(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	deleteRuleState := func(s *terraform.State) error {
 		return s.Remove("azurerm_lb_rule.test")
@@ -233,8 +233,8 @@ func TestAccAzureRMLoadBalancerRule_reapply(t *testing.T) {
 
 func TestAccAzureRMLoadBalancerRule_disappears(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := acctRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -278,7 +278,7 @@ func testCheckAzureRMLoadBalancerRuleNotExists(lbRuleName string, lb *network.Lo
 
 func testCheckAzureRMLoadBalancerRuleDisappears(ruleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+		conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient()
 
 		_, i, exists := findLoadBalancerRuleByName(lb, ruleName)
 		if !exists {
@@ -2,15 +2,60 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_lb", &resource.Sweeper{
+		Name: "azurerm_lb",
+		F:    testSweepLoadBalancers,
+		Dependencies: []string{
+			"azurerm_network_interface",
+			"azurerm_virtual_machine_scale_set",
+		},
+	})
+}
+
+func testSweepLoadBalancers(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.loadBalancerClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Load Balancers..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Load Balancers: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, loadBalancer := range *results.Value {
+				resources = append(resources, sweepableResource{loadBalancer.ID, loadBalancer.Name, loadBalancer.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Load Balancer", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestResourceAzureRMLoadBalancerPrivateIpAddressAllocation_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -49,7 +94,7 @@ func TestResourceAzureRMLoadBalancerPrivateIpAddressAllocation_validation(t *tes
 
 func TestAccAzureRMLoadBalancer_basic(t *testing.T) {
 	var lb network.LoadBalancer
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -69,7 +114,7 @@ func TestAccAzureRMLoadBalancer_basic(t *testing.T) {
 func TestAccAzureRMLoadBalancer_frontEndConfig(t *testing.T) {
 	var lb network.LoadBalancer
 	resourceName := "azurerm_lb.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +143,7 @@ func TestAccAzureRMLoadBalancer_frontEndConfig(t *testing.T) {
 func TestAccAzureRMLoadBalancer_tags(t *testing.T) {
 	var lb network.LoadBalancer
 	resourceName := "azurerm_lb.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -127,6 +172,73 @@ func TestAccAzureRMLoadBalancer_tags(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMLoadBalancer_fakeArmImportChildResources(t *testing.T) {
+	resourceName := "azurerm_lb.test"
+	ri := acctRandInt(t)
+	config := testAccAzureRMLoadBalancer_basic(ri, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	lbId := LoadBalancerID{
+		SubscriptionID: fakeArmSubscriptionID,
+		ResourceGroup:  fmt.Sprintf("acctestrg-%d", ri),
+		Name:           fmt.Sprintf("arm-test-loadbalancer-%d", ri),
+	}
+	poolId := LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   lbId.SubscriptionID,
+		ResourceGroup:    lbId.ResourceGroup,
+		LoadBalancerName: lbId.Name,
+		Name:             "pool1",
+	}
+	probeId := LoadBalancerProbeID{
+		SubscriptionID:   lbId.SubscriptionID,
+		ResourceGroup:    lbId.ResourceGroup,
+		LoadBalancerName: lbId.Name,
+		Name:             "probe1",
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_lb"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  server.checkExists(resourceName),
+			},
+			{
+				// add the child resources outside of Terraform
+				PreConfig: func() {
+					loadBalancer, _ := server.get(lbId.String())
+					properties := loadBalancer["properties"].(map[string]interface{})
+					properties["backendAddressPools"] = []interface{}{
+						map[string]interface{}{
+							"name":       "pool1",
+							"properties": map[string]interface{}{},
+						},
+					}
+					properties["probes"] = []interface{}{
+						map[string]interface{}{
+							"name": "probe1",
+							"properties": map[string]interface{}{
+								"protocol":          "Tcp",
+								"port":              22,
+								"intervalInSeconds": 5,
+								"numberOfProbes":    2,
+							},
+						},
+					}
+					server.put(lbId.String(), loadBalancer)
+				},
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateCheck: server.checkImported(lbId.String(), poolId.String(), probeId.String()),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMLoadBalancerExists(name string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -140,7 +252,7 @@ func testCheckAzureRMLoadBalancerExists(name string, lb *network.LoadBalancer) r
 			return fmt.Errorf("Bad: no resource group found in state for loadbalancer: %s", loadbalancerName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+		conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient()
 
 		resp, err := conn.Get(resourceGroup, loadbalancerName, "")
 		if err != nil {
@@ -158,7 +270,7 @@ func testCheckAzureRMLoadBalancerExists(name string, lb *network.LoadBalancer) r
 }
 
 func testCheckAzureRMLoadBalancerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+	conn := testAccProvider.Meta().(*ArmClient).loadBalancerClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_lb" {
@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +20,12 @@ func resourceArmLocalNetworkGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -50,7 +58,10 @@ func resourceArmLocalNetworkGateway() *schema.Resource {
 }
 
 func resourceArmLocalNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	lnetClient := meta.(*ArmClient).localNetConnClient
+	lnetClient := meta.(*ArmClient).localNetConnClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
@@ -74,7 +85,7 @@ func resourceArmLocalNetworkGatewayCreate(d *schema.ResourceData, meta interface
 		},
 	}
 
-	_, error := lnetClient.CreateOrUpdate(resGroup, name, gateway, make(chan struct{}))
+	_, error := lnetClient.CreateOrUpdate(resGroup, name, gateway, ctx.Done())
 	err := <-error
 	if err != nil {
 		return fmt.Errorf("Error creating Azure ARM Local Network Gateway '%s': %s", name, err)
@@ -95,13 +106,13 @@ func resourceArmLocalNetworkGatewayCreate(d *schema.ResourceData, meta interface
 
 // resourceArmLocalNetworkGatewayRead goes ahead and reads the state of the corresponding ARM local network gateway.
 func resourceArmLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	lnetClient := meta.(*ArmClient).localNetConnClient
+	lnetClient := meta.(*ArmClient).localNetConnClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseLocalNetworkGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["localNetworkGateways"]
+	name := id.Name
 	if name == "" {
 		return fmt.Errorf("Cannot find 'localNetworkGateways' in '%s', make sure it is specified in the ID parameter", d.Id())
 	}
@@ -132,16 +143,19 @@ func resourceArmLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}
 
 // resourceArmLocalNetworkGatewayDelete deletes the specified ARM local network gateway.
 func resourceArmLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	lnetClient := meta.(*ArmClient).localNetConnClient
+	lnetClient := meta.(*ArmClient).localNetConnClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseLocalNetworkGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["localNetworkGateways"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
-	deleteResp, error := lnetClient.Delete(resGroup, name, make(chan struct{}))
+	deleteResp, error := lnetClient.Delete(resGroup, name, ctx.Done())
 	resp := <-deleteResp
 	err = <-error
 
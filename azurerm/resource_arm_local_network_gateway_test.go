@@ -2,18 +2,59 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_local_network_gateway", &resource.Sweeper{
+		Name: "azurerm_local_network_gateway",
+		F:    testSweepLocalNetworkGateways,
+	})
+}
+
+func testSweepLocalNetworkGateways(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.localNetConnClient()
+	resources := make([]sweepableResource, 0)
+
+	resourceGroups, err := acceptanceTestResourceGroups(armClient, region)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceGroup := range resourceGroups {
+		log.Printf("Retrieving the Local Network Gateways in Resource Group '%s'..", resourceGroup)
+		results, err := client.List(resourceGroup)
+		if err != nil {
+			return fmt.Errorf("Error listing Local Network Gateways in Resource Group %q: %+v", resourceGroup, err)
+		}
+
+		if results.Value != nil {
+			for _, gateway := range *results.Value {
+				resources = append(resources, sweepableResource{gateway.ID, gateway.Name, gateway.Location})
+			}
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "Local Network Gateway", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMLocalNetworkGateway_basic(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
 
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -33,7 +74,7 @@ func TestAccAzureRMLocalNetworkGateway_basic(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_disappears(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -74,7 +115,7 @@ func testCheckAzureRMLocalNetworkGatewayExists(name string) resource.TestCheckFu
 		resGrp := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient()
 
 		resp, err := lnetClient.Get(resGrp, localNetName)
 		if err != nil {
@@ -105,7 +146,7 @@ func testCheckAzureRMLocalNetworkGatewayDisappears(name string) resource.TestChe
 		resGrp := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient()
 
 		deleteResp, error := lnetClient.Delete(resGrp, localNetName, make(chan struct{}))
 		resp := <-deleteResp
@@ -134,7 +175,7 @@ func testCheckAzureRMLocalNetworkGatewayDestroy(s *terraform.State) error {
 		localNetName := id.Path["localNetworkGateways"]
 		resGrp := id.ResourceGroup
 
-		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		lnetClient := testAccProvider.Meta().(*ArmClient).localNetConnClient()
 		resp, err := lnetClient.Get(resGrp, localNetName)
 
 		if err != nil {
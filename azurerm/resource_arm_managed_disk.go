@@ -1,9 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/disk"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +22,12 @@ func resourceArmManagedDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -100,14 +108,20 @@ func validateDiskSizeGB(v interface{}, k string) (ws []string, errors []error) {
 
 func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	diskClient := client.diskClient
+	diskClient := client.diskClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	createDisk := disk.Model{
@@ -150,8 +164,8 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 
 	createDisk.CreationData = creationData
 
-	_, diskErr := diskClient.CreateOrUpdate(resGroup, name, createDisk, make(chan struct{}))
-	err := <-diskErr
+	_, diskErr := diskClient.CreateOrUpdate(resGroup, name, createDisk, ctx.Done())
+	err = <-diskErr
 	if err != nil {
 		return err
 	}
@@ -170,14 +184,14 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
-	diskClient := meta.(*ArmClient).diskClient
+	diskClient := meta.(*ArmClient).diskClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseManagedDiskID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
 	resp, err := diskClient.Get(resGroup, name)
 	if err != nil {
@@ -200,22 +214,25 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 		flattenAzureRmManagedDiskCreationData(d, resp.CreationData)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	diskClient := meta.(*ArmClient).diskClient
+	diskClient := meta.(*ArmClient).diskClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseManagedDiskID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
-	_, error := diskClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := diskClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 	if err != nil {
 		return err
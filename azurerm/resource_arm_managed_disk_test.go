@@ -2,19 +2,64 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/azure-sdk-for-go/arm/disk"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_managed_disk", &resource.Sweeper{
+		Name: "azurerm_managed_disk",
+		F:    testSweepManagedDisks,
+		Dependencies: []string{
+			"azurerm_image",
+			"azurerm_virtual_machine",
+		},
+	})
+}
+
+func testSweepManagedDisks(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.diskClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Managed Disks..")
+	results, err := client.List()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Managed Disks: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, disk := range *results.Value {
+				resources = append(resources, sweepableResource{disk.ID, disk.Name, disk.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Managed Disk", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 	var d disk.Model
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMManagedDisk_empty(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -34,7 +79,7 @@ func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 func TestAccAzureRMManagedDisk_import(t *testing.T) {
 	var d disk.Model
 	var vm compute.VirtualMachine
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	vmConfig := testAccAzureRMVirtualMachine_basicLinuxMachine(ri, location)
 	config := testAccAzureRMManagedDisk_import(ri, location)
@@ -65,7 +110,7 @@ func TestAccAzureRMManagedDisk_import(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_copy(t *testing.T) {
 	var d disk.Model
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMManagedDisk_copy(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -86,7 +131,7 @@ func TestAccAzureRMManagedDisk_update(t *testing.T) {
 	var d disk.Model
 
 	resourceName := "azurerm_managed_disk.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMManagedDisk_empty(ri, testLocation())
 	postConfig := testAccAzureRMManagedDisk_empty_updated(ri, testLocation())
 	resource.Test(t, resource.TestCase{
@@ -121,7 +166,7 @@ func TestAccAzureRMManagedDisk_update(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_NonStandardCasing(t *testing.T) {
 	var d disk.Model
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMManagedDiskNonStandardCasing(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -156,7 +201,7 @@ func testCheckAzureRMManagedDiskExists(name string, d *disk.Model, shouldExist b
 			return fmt.Errorf("Bad: no resource group found in state for disk: %s", dName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).diskClient
+		conn := testAccProvider.Meta().(*ArmClient).diskClient()
 
 		resp, err := conn.Get(resourceGroup, dName)
 		if err != nil {
@@ -177,7 +222,7 @@ func testCheckAzureRMManagedDiskExists(name string, d *disk.Model, shouldExist b
 }
 
 func testCheckAzureRMManagedDiskDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).diskClient
+	conn := testAccProvider.Meta().(*ArmClient).diskClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_managed_disk" {
@@ -214,7 +259,7 @@ func testDeleteAzureRMVirtualMachine(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", vmName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).vmClient
+		conn := testAccProvider.Meta().(*ArmClient).vmClient()
 
 		_, error := conn.Delete(resourceGroup, vmName, make(chan struct{}))
 		err := <-error
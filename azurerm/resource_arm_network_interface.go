@@ -2,9 +2,11 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -21,6 +23,12 @@ func resourceArmNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -155,7 +163,10 @@ func resourceArmNetworkInterface() *schema.Resource {
 
 func resourceArmNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	ifaceClient := client.ifaceClient
+	ifaceClient := client.ifaceClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Network Interface creation.")
 
@@ -163,7 +174,10 @@ func resourceArmNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	enableIpForwarding := d.Get("enable_ip_forwarding").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	properties := network.InterfacePropertiesFormat{
 		EnableIPForwarding: &enableIpForwarding,
@@ -229,8 +243,8 @@ func resourceArmNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 		Tags: expandTags(tags),
 	}
 
-	_, error := ifaceClient.CreateOrUpdate(resGroup, name, iface, make(chan struct{}))
-	err := <-error
+	_, error := ifaceClient.CreateOrUpdate(resGroup, name, iface, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -249,14 +263,14 @@ func resourceArmNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	ifaceClient := meta.(*ArmClient).ifaceClient
+	ifaceClient := meta.(*ArmClient).ifaceClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkInterfaces"]
+	name := id.Name
 
 	resp, err := ifaceClient.Get(resGroup, name, "")
 	if err != nil {
@@ -330,20 +344,23 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("dns_servers", dnsServers)
 	d.Set("enable_ip_forwarding", resp.EnableIPForwarding)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	ifaceClient := meta.(*ArmClient).ifaceClient
+	ifaceClient := meta.(*ArmClient).ifaceClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkInterfaces"]
+	name := id.Name
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
@@ -364,14 +381,14 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		data := configRaw.(map[string]interface{})
 
 		subnet_id := data["subnet_id"].(string)
-		subnetId, err := parseAzureResourceID(subnet_id)
+		subnetId, err := parseSubnetID(subnet_id)
 		if err != nil {
 			return err
 		}
-		subnetName := subnetId.Path["subnets"]
+		subnetName := subnetId.Name
 		subnetNamesToLock = append(subnetNamesToLock, subnetName)
 
-		virtualNetworkName := subnetId.Path["virtualNetworks"]
+		virtualNetworkName := subnetId.VirtualNetworkName
 		virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 	}
 
@@ -381,7 +398,7 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 	azureRMLockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
 
-	_, error := ifaceClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := ifaceClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -496,12 +513,12 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 			PrivateIPAllocationMethod: allocationMethod,
 		}
 
-		subnetId, err := parseAzureResourceID(subnet_id)
+		subnetId, err := parseSubnetID(subnet_id)
 		if err != nil {
 			return []network.InterfaceIPConfiguration{}, nil, nil, err
 		}
-		subnetName := subnetId.Path["subnets"]
-		virtualNetworkName := subnetId.Path["virtualNetworks"]
+		subnetName := subnetId.Name
+		virtualNetworkName := subnetId.VirtualNetworkName
 		subnetNamesToLock = append(subnetNamesToLock, subnetName)
 		virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 
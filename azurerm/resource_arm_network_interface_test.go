@@ -2,16 +2,60 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_network_interface", &resource.Sweeper{
+		Name: "azurerm_network_interface",
+		F:    testSweepNetworkInterfaces,
+		Dependencies: []string{
+			"azurerm_virtual_machine",
+		},
+	})
+}
+
+func testSweepNetworkInterfaces(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.ifaceClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Network Interfaces..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Network Interfaces: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, iface := range *results.Value {
+				resources = append(resources, sweepableResource{iface.ID, iface.Name, iface.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Network Interface", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMNetworkInterface_basic(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -28,7 +72,7 @@ func TestAccAzureRMNetworkInterface_basic(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_disappears(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -47,7 +91,7 @@ func TestAccAzureRMNetworkInterface_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_enableIPForwarding(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -66,7 +110,7 @@ func TestAccAzureRMNetworkInterface_enableIPForwarding(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_multipleLoadBalancers(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -84,7 +128,7 @@ func TestAccAzureRMNetworkInterface_multipleLoadBalancers(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_withTags(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -117,7 +161,7 @@ func TestAccAzureRMNetworkInterface_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_bug7986(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -148,7 +192,7 @@ func testCheckAzureRMNetworkInterfaceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).ifaceClient
+		conn := testAccProvider.Meta().(*ArmClient).ifaceClient()
 
 		resp, err := conn.Get(resourceGroup, name, "")
 		if err != nil {
@@ -177,7 +221,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).ifaceClient
+		conn := testAccProvider.Meta().(*ArmClient).ifaceClient()
 
 		_, error := conn.Delete(resourceGroup, name, make(chan struct{}))
 		err := <-error
@@ -190,7 +234,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 }
 
 func testCheckAzureRMNetworkInterfaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).ifaceClient
+	conn := testAccProvider.Meta().(*ArmClient).ifaceClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_network_interface" {
@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
@@ -14,6 +16,11 @@ import (
 
 var networkSecurityGroupResourceName = "azurerm_network_security_group"
 
+// networkSecurityGroupImportRulesSuffix can be appended to the ID of a Network Security Group when importing it,
+// to also import each of its Security Rules as an `azurerm_network_security_rule`. This is opt-in since these
+// conflict with Security Rules defined inline using `security_rule` blocks.
+const networkSecurityGroupImportRulesSuffix = "/securityRules"
+
 func resourceArmNetworkSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNetworkSecurityGroupCreate,
@@ -21,7 +28,13 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 		Update: resourceArmNetworkSecurityGroupCreate,
 		Delete: resourceArmNetworkSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmNetworkSecurityGroupImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,12 +139,18 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 
 func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	secClient := client.secGroupClient
+	secClient := client.secGroupClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	sgRules, sgErr := expandAzureRmSecurityRules(d)
 	if sgErr != nil {
@@ -150,8 +169,8 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 		Tags: expandTags(tags),
 	}
 
-	_, error := secClient.CreateOrUpdate(resGroup, name, sg, make(chan struct{}))
-	err := <-error
+	_, error := secClient.CreateOrUpdate(resGroup, name, sg, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -169,7 +188,7 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    networkSecurityGroupStateRefreshFunc(client, resGroup, name),
-		Timeout:    30 * time.Minute,
+		Timeout:    createOrUpdateTimeout(d),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -182,14 +201,14 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	secGroupClient := meta.(*ArmClient).secGroupClient
+	secGroupClient := meta.(*ArmClient).secGroupClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkSecurityGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkSecurityGroups"]
+	name := id.Name
 
 	resp, err := secGroupClient.Get(resGroup, name, "")
 	if err != nil {
@@ -207,22 +226,78 @@ func resourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{
 	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
+// resourceArmNetworkSecurityGroupImportState imports the Network Security Group - along with each of its
+// Security Rules as an `azurerm_network_security_rule` when the ID ends with `/securityRules`.
+func resourceArmNetworkSecurityGroupImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	secGroupClient := meta.(*ArmClient).secGroupClient()
+
+	groupId := d.Id()
+	importRules := strings.HasSuffix(strings.ToLower(groupId), strings.ToLower(networkSecurityGroupImportRulesSuffix))
+	if importRules {
+		groupId = groupId[:len(groupId)-len(networkSecurityGroupImportRulesSuffix)]
+	}
+
+	id, err := parseNetworkSecurityGroupID(groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*schema.ResourceData{d}
+	if !importRules {
+		return results, nil
+	}
+
+	d.SetId(groupId)
+
+	resp, err := secGroupClient.Get(id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if resp.SecurityGroupPropertiesFormat == nil || resp.SecurityGroupPropertiesFormat.SecurityRules == nil {
+		return results, nil
+	}
+
+	for _, rule := range *resp.SecurityGroupPropertiesFormat.SecurityRules {
+		if rule.Name == nil {
+			continue
+		}
+
+		ruleId := NetworkSecurityRuleID{
+			SubscriptionID:           id.SubscriptionID,
+			ResourceGroup:            id.ResourceGroup,
+			NetworkSecurityGroupName: id.Name,
+			Name:                     *rule.Name,
+		}
+
+		child := resourceArmNetworkSecurityRule().Data(nil)
+		child.SetId(ruleId.String())
+		child.SetType("azurerm_network_security_rule")
+		results = append(results, child)
+	}
+
+	return results, nil
+}
+
 func resourceArmNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	secGroupClient := meta.(*ArmClient).secGroupClient
+	secGroupClient := meta.(*ArmClient).secGroupClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkSecurityGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkSecurityGroups"]
+	name := id.Name
 
-	_, error := secGroupClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := secGroupClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -308,7 +383,7 @@ func expandAzureRmSecurityRules(d *schema.ResourceData) ([]network.SecurityRule,
 
 func networkSecurityGroupStateRefreshFunc(client *ArmClient, resourceGroupName string, sgName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.secGroupClient.Get(resourceGroupName, sgName, "")
+		res, err := client.secGroupClient().Get(resourceGroupName, sgName, "")
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in networkSecurityGroupStateRefreshFunc to Azure ARM for NSG '%s' (RG: '%s'): %s", sgName, resourceGroupName, err)
 		}
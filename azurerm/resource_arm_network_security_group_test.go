@@ -2,16 +2,61 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_network_security_group", &resource.Sweeper{
+		Name: "azurerm_network_security_group",
+		F:    testSweepNetworkSecurityGroups,
+		Dependencies: []string{
+			"azurerm_network_interface",
+			"azurerm_virtual_network",
+		},
+	})
+}
+
+func testSweepNetworkSecurityGroups(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.secGroupClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Network Security Groups..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Network Security Groups: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, group := range *results.Value {
+				resources = append(resources, sweepableResource{group.ID, group.Name, group.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Network Security Group", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMNetworkSecurityGroup_basic(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -28,7 +73,7 @@ func TestAccAzureRMNetworkSecurityGroup_basic(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkSecurityGroup_disappears(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -47,7 +92,7 @@ func TestAccAzureRMNetworkSecurityGroup_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkSecurityGroup_withTags(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -81,7 +126,7 @@ func TestAccAzureRMNetworkSecurityGroup_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkSecurityGroup_addingExtraRules(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := acctRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -108,6 +153,60 @@ func TestAccAzureRMNetworkSecurityGroup_addingExtraRules(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMNetworkSecurityGroup_fakeArmImportSecurityRules(t *testing.T) {
+	resourceName := "azurerm_network_security_group.test"
+	ri := acctRandInt(t)
+	config := testAccAzureRMNetworkSecurityGroup_basic(ri, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	groupId := NetworkSecurityGroupID{
+		SubscriptionID: fakeArmSubscriptionID,
+		ResourceGroup:  fmt.Sprintf("acctestRG-%d", ri),
+		Name:           "acceptanceTestSecurityGroup1",
+	}
+	ruleId := NetworkSecurityRuleID{
+		SubscriptionID:           groupId.SubscriptionID,
+		ResourceGroup:            groupId.ResourceGroup,
+		NetworkSecurityGroupName: groupId.Name,
+		Name:                     "test123",
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_network_security_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  server.checkExists(resourceName),
+			},
+			{
+				// ARM exposes the Security Rules defined inline as child resources
+				PreConfig: func() {
+					group, _ := server.get(groupId.String())
+					properties := group["properties"].(map[string]interface{})
+					rule := properties["securityRules"].([]interface{})[0].(map[string]interface{})
+					rule["id"] = ruleId.String()
+					server.put(ruleId.String(), rule)
+				},
+				// the Security Rules aren't imported unless requested, since these conflict with `security_rule`
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateCheck:  server.checkImported(groupId.String()),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateId:    groupId.String() + "/securityRules",
+				ImportStateCheck: server.checkImported(groupId.String(), ruleId.String()),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMNetworkSecurityGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -122,7 +221,7 @@ func testCheckAzureRMNetworkSecurityGroupExists(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for network security group: %s", sgName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).secGroupClient
+		conn := testAccProvider.Meta().(*ArmClient).secGroupClient()
 
 		resp, err := conn.Get(resourceGroup, sgName, "")
 		if err != nil {
@@ -151,7 +250,7 @@ func testCheckAzureRMNetworkSecurityGroupDisappears(name string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for network security group: %s", sgName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).secGroupClient
+		conn := testAccProvider.Meta().(*ArmClient).secGroupClient()
 
 		_, error := conn.Delete(resourceGroup, sgName, make(chan struct{}))
 		err := <-error
@@ -164,7 +263,7 @@ func testCheckAzureRMNetworkSecurityGroupDisappears(name string) resource.TestCh
 }
 
 func testCheckAzureRMNetworkSecurityGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).secGroupClient
+	conn := testAccProvider.Meta().(*ArmClient).secGroupClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_network_security_group" {
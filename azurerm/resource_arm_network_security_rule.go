@@ -1,7 +1,9 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +19,12 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -105,7 +113,10 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 
 func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	secClient := client.secRuleClient
+	secClient := client.secRuleClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	nsgName := d.Get("network_security_group_name").(string)
@@ -144,7 +155,7 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 		SecurityRulePropertiesFormat: &properties,
 	}
 
-	_, error := secClient.CreateOrUpdate(resGroup, nsgName, name, sgr, make(chan struct{}))
+	_, error := secClient.CreateOrUpdate(resGroup, nsgName, name, sgr, ctx.Done())
 	err := <-error
 	if err != nil {
 		return err
@@ -164,15 +175,15 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) error {
-	secRuleClient := meta.(*ArmClient).secRuleClient
+	secRuleClient := meta.(*ArmClient).secRuleClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkSecurityRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	networkSGName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	networkSGName := id.NetworkSecurityGroupName
+	sgRuleName := id.Name
 
 	resp, err := secRuleClient.Get(resGroup, networkSGName, sgRuleName)
 	if err != nil {
@@ -200,20 +211,23 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	secRuleClient := client.secRuleClient
+	secRuleClient := client.secRuleClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseNetworkSecurityRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	nsgName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	nsgName := id.NetworkSecurityGroupName
+	sgRuleName := id.Name
 
 	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
 	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
 
-	_, error := secRuleClient.Delete(resGroup, nsgName, sgRuleName, make(chan struct{}))
+	_, error := secRuleClient.Delete(resGroup, nsgName, sgRuleName, ctx.Done())
 	err = <-error
 
 	return err
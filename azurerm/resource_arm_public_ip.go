@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +22,12 @@ func resourceArmPublicIp() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -84,14 +92,20 @@ func resourceArmPublicIp() *schema.Resource {
 
 func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	publicIPClient := client.publicIPClient
+	publicIPClient := client.publicIPClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Public IP creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	properties := network.PublicIPAddressPropertiesFormat{
 		PublicIPAllocationMethod: network.IPAllocationMethod(d.Get("public_ip_address_allocation").(string)),
@@ -129,8 +143,8 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		Tags: expandTags(tags),
 	}
 
-	_, error := publicIPClient.CreateOrUpdate(resGroup, name, publicIp, make(chan struct{}))
-	err := <-error
+	_, error := publicIPClient.CreateOrUpdate(resGroup, name, publicIp, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -149,14 +163,14 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
-	publicIPClient := meta.(*ArmClient).publicIPClient
+	publicIPClient := meta.(*ArmClient).publicIPClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
 	resp, err := publicIPClient.Get(resGroup, name, "")
 	if err != nil {
@@ -180,22 +194,25 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("ip_address", resp.PublicIPAddressPropertiesFormat.IPAddress)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
-	publicIPClient := meta.(*ArmClient).publicIPClient
+	publicIPClient := meta.(*ArmClient).publicIPClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
-	_, error := publicIPClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := publicIPClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
@@ -10,6 +11,51 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_public_ip", &resource.Sweeper{
+		Name: "azurerm_public_ip",
+		F:    testSweepPublicIPs,
+		Dependencies: []string{
+			"azurerm_lb",
+			"azurerm_network_interface",
+		},
+	})
+}
+
+func testSweepPublicIPs(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.publicIPClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Public IPs..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Public IPs: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, ip := range *results.Value {
+				resources = append(resources, sweepableResource{ip.ID, ip.Name, ip.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Public IP", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestResourceAzureRMPublicIpAllocation_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -80,7 +126,7 @@ func TestResourceAzureRMPublicIpDomainNameLabel_validation(t *testing.T) {
 
 func TestAccAzureRMPublicIpStatic_basic(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMPublicIPStatic_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -100,7 +146,7 @@ func TestAccAzureRMPublicIpStatic_basic(t *testing.T) {
 
 func TestAccAzureRMPublicIpStatic_disappears(t *testing.T) {
 	resourceName := "azurerm_public_ip.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMPublicIPStatic_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -122,7 +168,7 @@ func TestAccAzureRMPublicIpStatic_disappears(t *testing.T) {
 
 func TestAccAzureRMPublicIpStatic_idleTimeout(t *testing.T) {
 	resourceName := "azurerm_public_ip.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMPublicIPStatic_idleTimeout(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -143,7 +189,7 @@ func TestAccAzureRMPublicIpStatic_idleTimeout(t *testing.T) {
 
 func TestAccAzureRMPublicIpStatic_withTags(t *testing.T) {
 	resourceName := "azurerm_public_ip.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMPublicIPStatic_withTags(ri, location)
 	postConfig := testAccAzureRMPublicIPStatic_withTagsUpdate(ri, location)
@@ -176,7 +222,7 @@ func TestAccAzureRMPublicIpStatic_withTags(t *testing.T) {
 
 func TestAccAzureRMPublicIpStatic_update(t *testing.T) {
 	resourceName := "azurerm_public_ip.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMPublicIPStatic_basic(ri, location)
 	postConfig := testAccAzureRMPublicIPStatic_update(ri, location)
@@ -204,7 +250,7 @@ func TestAccAzureRMPublicIpStatic_update(t *testing.T) {
 }
 
 func TestAccAzureRMPublicIpDynamic_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMPublicIPDynamic_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -236,7 +282,7 @@ func testCheckAzureRMPublicIpExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for public ip: %s", availSetName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).publicIPClient
+		conn := testAccProvider.Meta().(*ArmClient).publicIPClient()
 
 		resp, err := conn.Get(resourceGroup, availSetName, "")
 		if err != nil {
@@ -265,7 +311,7 @@ func testCheckAzureRMPublicIpDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for public ip: %s", publicIpName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).publicIPClient
+		conn := testAccProvider.Meta().(*ArmClient).publicIPClient()
 
 		_, error := conn.Delete(resourceGroup, publicIpName, make(chan struct{}))
 		err := <-error
@@ -278,7 +324,7 @@ func testCheckAzureRMPublicIpDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMPublicIpDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).publicIPClient
+	conn := testAccProvider.Meta().(*ArmClient).publicIPClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_public_ip" {
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -9,10 +10,10 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/redis"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmRedisCache() *schema.Resource {
@@ -21,6 +22,14 @@ func resourceArmRedisCache() *schema.Resource {
 		Read:   resourceArmRedisCacheRead,
 		Update: resourceArmRedisCacheUpdate,
 		Delete: resourceArmRedisCacheDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -158,9 +167,12 @@ func resourceArmRedisCache() *schema.Resource {
 }
 
 func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisClient
+	client := meta.(*ArmClient).redisClient()
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache creation.")
 
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -171,7 +183,10 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 	family := redis.SkuFamily(d.Get("family").(string))
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	parameters := redis.CreateParameters{
@@ -194,10 +209,27 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 		parameters.ShardCount = &shardCount
 	}
 
-	_, error := client.Create(resGroup, name, parameters, make(chan struct{}))
-	err := <-error
+	// wait for any operation started by a previous (interrupted) run to complete, since this request would otherwise Conflict
+	pendingReq, err := client.GetPreparer(resGroup, name)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error preparing the request for Redis Cache %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if err := waitForPendingOperation(ctx, client.Client, pendingReq.URL.String()); err != nil {
+		return fmt.Errorf("Error waiting for the pending operation on Redis Cache %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	operation := &pendingOperation{}
+	operation.track(&client.Client)
+
+	_, error := client.Create(resGroup, name, parameters, ctx.Done())
+	err = <-error
+	if err != nil {
+		id := RedisCacheID{
+			SubscriptionID: meta.(*ArmClient).subscriptionId,
+			ResourceGroup:  resGroup,
+			Name:           name,
+		}
+		return operation.persistIfAccepted(meta.(*ArmClient).StopContext, d, id.String(), err)
 	}
 
 	read, err := client.Get(resGroup, name)
@@ -213,7 +245,7 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    redisStateRefreshFunc(client, resGroup, name),
-		Timeout:    60 * time.Minute,
+		Timeout:    remainingTimeout(ctx, d.Timeout(schema.TimeoutCreate)),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -226,7 +258,11 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisClient
+	client := meta.(*ArmClient).redisClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache update.")
 
 	name := d.Get("name").(string)
@@ -238,7 +274,10 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 	family := redis.SkuFamily(d.Get("family").(string))
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	parameters := redis.UpdateParameters{
@@ -265,7 +304,16 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 		parameters.RedisConfiguration = redisConfiguration
 	}
 
-	_, err := client.Update(resGroup, name, parameters)
+	// wait for any operation started by a previous (interrupted) run to complete, since this request would otherwise Conflict
+	pendingReq, err := client.GetPreparer(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error preparing the request for Redis Cache %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if err := waitForPendingOperation(ctx, client.Client, pendingReq.URL.String()); err != nil {
+		return fmt.Errorf("Error waiting for the pending operation on Redis Cache %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	_, err = client.Update(resGroup, name, parameters)
 	if err != nil {
 		return err
 	}
@@ -283,7 +331,7 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    redisStateRefreshFunc(client, resGroup, name),
-		Timeout:    60 * time.Minute,
+		Timeout:    remainingTimeout(ctx, d.Timeout(schema.TimeoutUpdate)),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -296,14 +344,14 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisClient
+	client := meta.(*ArmClient).redisClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRedisCacheID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["Redis"]
+	name := id.Name
 
 	resp, err := client.Get(resGroup, name)
 
@@ -343,22 +391,25 @@ func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("primary_access_key", keysResp.PrimaryKey)
 	d.Set("secondary_access_key", keysResp.SecondaryKey)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
-	redisClient := meta.(*ArmClient).redisClient
+	redisClient := meta.(*ArmClient).redisClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRedisCacheID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["Redis"]
+	name := id.Name
 
-	deleteResp, error := redisClient.Delete(resGroup, name, make(chan struct{}))
+	deleteResp, error := redisClient.Delete(resGroup, name, ctx.Done())
 	resp := <-deleteResp
 	err = <-error
 
@@ -390,41 +441,41 @@ func expandRedisConfiguration(d *schema.ResourceData) *map[string]*string {
 
 	if v, ok := d.GetOk("redis_configuration.0.maxclients"); ok {
 		clients := strconv.Itoa(v.(int))
-		output["maxclients"] = azure.String(clients)
+		output["maxclients"] = to.StringPtr(clients)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.maxmemory_delta"); ok {
 		delta := strconv.Itoa(v.(int))
-		output["maxmemory-delta"] = azure.String(delta)
+		output["maxmemory-delta"] = to.StringPtr(delta)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.maxmemory_reserved"); ok {
 		delta := strconv.Itoa(v.(int))
-		output["maxmemory-reserved"] = azure.String(delta)
+		output["maxmemory-reserved"] = to.StringPtr(delta)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.maxmemory_policy"); ok {
-		output["maxmemory-policy"] = azure.String(v.(string))
+		output["maxmemory-policy"] = to.StringPtr(v.(string))
 	}
 
 	// Backup
 	if v, ok := d.GetOk("redis_configuration.0.rdb_backup_enabled"); ok {
 		delta := strconv.FormatBool(v.(bool))
-		output["rdb-backup-enabled"] = azure.String(delta)
+		output["rdb-backup-enabled"] = to.StringPtr(delta)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.rdb_backup_frequency"); ok {
 		delta := strconv.Itoa(v.(int))
-		output["rdb-backup-frequency"] = azure.String(delta)
+		output["rdb-backup-frequency"] = to.StringPtr(delta)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.rdb_backup_max_snapshot_count"); ok {
 		delta := strconv.Itoa(v.(int))
-		output["rdb-backup-max-snapshot-count"] = azure.String(delta)
+		output["rdb-backup-max-snapshot-count"] = to.StringPtr(delta)
 	}
 
 	if v, ok := d.GetOk("redis_configuration.0.rdb_storage_connection_string"); ok {
-		output["rdb-storage-connection-string"] = azure.String(v.(string))
+		output["rdb-storage-connection-string"] = to.StringPtr(v.(string))
 	}
 
 	return &output
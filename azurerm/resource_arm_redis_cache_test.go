@@ -2,14 +2,55 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_redis_cache", &resource.Sweeper{
+		Name: "azurerm_redis_cache",
+		F:    testSweepRedisCaches,
+	})
+}
+
+func testSweepRedisCaches(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.redisClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Redis Caches..")
+	results, err := client.List()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Redis Caches: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, cache := range *results.Value {
+				resources = append(resources, sweepableResource{cache.ID, cache.Name, cache.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Redis Cache", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMRedisCacheFamily_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -104,7 +145,7 @@ func TestAccAzureRMRedisCacheBackupFrequency_validation(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCache_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -123,7 +164,7 @@ func TestAccAzureRMRedisCache_basic(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_standard(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCache_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -142,7 +183,7 @@ func TestAccAzureRMRedisCache_standard(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_premium(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCache_premium(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -161,7 +202,7 @@ func TestAccAzureRMRedisCache_premium(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCache_premiumSharded(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -180,7 +221,7 @@ func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCacheNonStandardCasing(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -205,7 +246,7 @@ func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRedisCacheBackupDisabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -224,8 +265,8 @@ func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -244,8 +285,8 @@ func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	location := testLocation()
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheBackupDisabled(ri, location)
@@ -285,7 +326,7 @@ func testCheckAzureRMRedisCacheExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Redis Instance: %s", redisName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).redisClient
+		conn := testAccProvider.Meta().(*ArmClient).redisClient()
 
 		resp, err := conn.Get(resourceGroup, redisName)
 		if err != nil {
@@ -301,7 +342,7 @@ func testCheckAzureRMRedisCacheExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMRedisCacheDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).redisClient
+	conn := testAccProvider.Meta().(*ArmClient).redisClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_redis_cache" {
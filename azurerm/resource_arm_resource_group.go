@@ -1,17 +1,20 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmResourceGroup() *schema.Resource {
-	return &schema.Resource{
+	return withSubscriptionOverride(&schema.Resource{
 		Create: resourceArmResourceGroupCreate,
 		Read:   resourceArmResourceGroupRead,
 		Update: resourceArmResourceGroupUpdate,
@@ -21,6 +24,15 @@ func resourceArmResourceGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		MigrateState:  resourceAzureRMResourceGroupMigrateState,
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -33,7 +45,7 @@ func resourceArmResourceGroup() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func validateArmResourceGroupName(v interface{}, k string) (ws []string, es []error) {
@@ -55,129 +67,122 @@ func validateArmResourceGroupName(v interface{}, k string) (ws []string, es []er
 }
 
 func resourceArmResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+	client := meta.(*ArmClient).resourceGroupClient()
 
-	if !d.HasChange("tags") {
+	if !d.HasChange("tags") && !d.HasChange("outdated_tags") {
 		return nil
 	}
 
-	name := d.Get("name").(string)
-	newTags := d.Get("tags").(map[string]interface{})
-
-	updateRequest := rivieraClient.NewRequestForURI(d.Id())
-	updateRequest.Command = &azure.UpdateResourceGroup{
-		Name: name,
-		Tags: *expandTags(newTags),
+	id, err := parseResourceGroupID(d.Id())
+	if err != nil {
+		return err
 	}
 
-	updateResponse, err := updateRequest.Execute()
+	newTags, err := mergeDefaultTags(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error updating resource group: %s", err)
+		return err
 	}
-	if !updateResponse.IsSuccessful() {
-		return fmt.Errorf("Error updating resource group: %s", updateResponse.Error)
+
+	parameters := resources.Group{
+		Tags: expandTags(newTags),
+	}
+
+	if _, err := client.Patch(id.Name, parameters); err != nil {
+		return fmt.Errorf("Error updating Resource Group %q: %+v", id.Name, err)
 	}
 
 	return resourceArmResourceGroupRead(d, meta)
 }
 
 func resourceArmResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+	client := meta.(*ArmClient).resourceGroupClient()
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = &azure.CreateResourceGroup{
-		Name:     d.Get("name").(string),
-		Location: d.Get("location").(string),
-		Tags:     *expandTags(d.Get("tags").(map[string]interface{})),
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
 	}
 
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating resource group: %s", err)
+	parameters := resources.Group{
+		Location: &location,
+		Tags:     expandTags(tags),
 	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating resource group: %s", createResponse.Error)
+
+	if _, err := client.CreateOrUpdate(name, parameters); err != nil {
+		return fmt.Errorf("Error creating Resource Group %q: %+v", name, err)
 	}
 
-	resp := createResponse.Parsed.(*azure.CreateResourceGroupResponse)
-	d.SetId(*resp.ID)
+	read, err := client.Get(name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Group %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Resource Group %q ID", name)
+	}
 
-	// TODO(jen20): Decide whether we need this or not and migrate to use @stack72's work if so
-	// log.Printf("[DEBUG] Waiting for Resource Group (%s) to become available", name)
-	// stateConf := &resource.StateChangeConf{
-	// 	Pending: []string{"Accepted"},
-	// 	Target:  []string{"Succeeded"},
-	// 	Refresh: resourceGroupStateRefreshFunc(client, name),
-	// 	Timeout: 10 * time.Minute,
-	// }
-	// if _, err := stateConf.WaitForState(); err != nil {
-	// 	return fmt.Errorf("Error waiting for Resource Group (%s) to become available: %s", name, err)
-	// }
+	d.SetId(*read.ID)
 
 	return resourceArmResourceGroupRead(d, meta)
 }
 
 func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &azure.GetResourceGroup{}
+	client := meta.(*ArmClient).resourceGroupClient()
 
-	readResponse, err := readRequest.Execute()
+	id, err := parseResourceGroupID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error reading resource group: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading resource group %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading resource group: %s", readResponse.Error)
+		return err
 	}
 
-	resp := readResponse.Parsed.(*azure.GetResourceGroupResponse)
+	resp, err := client.Get(id.Name)
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Resource Group %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Resource Group %q: %+v", id.Name, err)
+	}
 
 	d.Set("name", resp.Name)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &azure.GetResourceGroup{}
+	client := meta.(*ArmClient).resourceGroupClient()
 
-	readResponse, err := readRequest.Execute()
+	id, err := parseResourceGroupID(d.Id())
 	if err != nil {
-		return false, fmt.Errorf("Error reading resource group: %s", err)
+		return false, err
 	}
-	if readResponse.IsSuccessful() {
-		return true, nil
+
+	resp, err := client.CheckExistence(id.Name)
+	if err != nil {
+		return false, fmt.Errorf("Error checking if Resource Group %q exists: %+v", id.Name, err)
 	}
 
-	return false, nil
+	return resp.StatusCode != http.StatusNotFound, nil
 }
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+	client := meta.(*ArmClient).resourceGroupClient()
 
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &azure.DeleteResourceGroup{}
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	deleteResponse, err := deleteRequest.Execute()
+	id, err := parseResourceGroupID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting resource group: %s", err)
+		return err
 	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting resource group: %s", deleteResponse.Error)
+
+	_, errChan := client.Delete(id.Name, ctx.Done())
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error deleting Resource Group %q: %+v", id.Name, err)
 	}
 
 	return nil
-
 }
@@ -2,16 +2,88 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	// Resource Groups are swept last, which removes anything left behind within them
+	resource.AddTestSweepers("azurerm_resource_group", &resource.Sweeper{
+		Name: "azurerm_resource_group",
+		F:    testSweepResourceGroups,
+		Dependencies: []string{
+			"azurerm_application_insights",
+			"azurerm_availability_set",
+			"azurerm_cdn_profile",
+			"azurerm_container_registry",
+			"azurerm_container_service",
+			"azurerm_cosmosdb_account",
+			"azurerm_dns_zone",
+			"azurerm_eventhub_namespace",
+			"azurerm_express_route_circuit",
+			"azurerm_image",
+			"azurerm_key_vault",
+			"azurerm_lb",
+			"azurerm_local_network_gateway",
+			"azurerm_managed_disk",
+			"azurerm_network_interface",
+			"azurerm_network_security_group",
+			"azurerm_public_ip",
+			"azurerm_redis_cache",
+			"azurerm_route_table",
+			"azurerm_search_service",
+			"azurerm_servicebus_namespace",
+			"azurerm_sql_server",
+			"azurerm_storage_account",
+			"azurerm_traffic_manager_profile",
+			"azurerm_virtual_machine",
+			"azurerm_virtual_machine_scale_set",
+			"azurerm_virtual_network",
+		},
+	})
+}
+
+func testSweepResourceGroups(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.resourceGroupClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Resource Groups..")
+	results, err := client.List("", nil)
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Resource Groups: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, group := range *results.Value {
+				resources = append(resources, sweepableResource{group.ID, group.Name, group.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Resource Group", resources, func(_ string, name string) error {
+		_, errors := client.Delete(name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMResourceGroup_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +103,7 @@ func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMResourceGroup_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -53,7 +125,7 @@ func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMResourceGroup_withTags(ri, location)
 	postConfig := testAccAzureRMResourceGroup_withTagsUpdated(ri, location)
@@ -84,6 +156,152 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMResourceGroup_fakeArm(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctRandInt(t)
+	preConfig := testAccAzureRMResourceGroup_withTags(ri, "West US")
+	postConfig := testAccAzureRMResourceGroup_withTagsUpdated(ri, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.longRunning("Microsoft.Resources/resourceGroups", http.MethodDelete)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_resource_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMResourceGroup_fakeArmSubscriptionOverride(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctRandInt(t)
+	subscriptionID := "11111111-1111-1111-1111-111111111111"
+	config := testAccAzureRMResourceGroup_subscriptionOverride(ri, "West US", subscriptionID)
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.addSubscription(subscriptionID)
+
+	expectedID := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", subscriptionID, ri)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_resource_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", expectedID),
+					resource.TestCheckResourceAttr(resourceName, "subscription_id", subscriptionID),
+				),
+			},
+			{
+				// the Subscription is determined from the ID when importing
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMResourceGroup_fakeArmDefaultTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctRandInt(t)
+	preConfig := testResourceAzureRMResourceGroup_defaultTags(ri, "West US", "platform")
+	postConfig := testResourceAzureRMResourceGroup_defaultTags(ri, "West US", "web")
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakeArmSubscriptionID, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	checkAppliedTags := func(expected map[string]string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			group, _ := server.get(groupId)
+			tags, _ := group["tags"].(map[string]interface{})
+			if len(tags) != len(expected) {
+				return fmt.Errorf("Expected the Tags %+v to be applied but got %+v", expected, tags)
+			}
+			for k, v := range expected {
+				if tags[k] != v {
+					return fmt.Errorf("Expected the Tags %+v to be applied but got %+v", expected, tags)
+				}
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_resource_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					checkAppliedTags(map[string]string{"owner": "platform"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outdated_tags.%", "0"),
+				),
+			},
+			{
+				// changing the Default Tags needs to show up in the plan
+				Config:             postConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// adding a Default Tag also needs to show up in the plan
+				Config:             strings.Replace(preConfig, "owner", "cost-centre = \"1234\"\n    owner", 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkAppliedTags(map[string]string{"owner": "web"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outdated_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -95,7 +313,7 @@ func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient
+		conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient()
 
 		resp, err := conn.Get(resourceGroup)
 		if err != nil {
@@ -121,7 +339,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient
+		conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient()
 
 		_, error := conn.Delete(resourceGroup, make(chan struct{}))
 		err := <-error
@@ -134,7 +352,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient
+	conn := testAccProvider.Meta().(*ArmClient).resourceGroupClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_resource_group" {
@@ -191,3 +409,28 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_subscriptionOverride(rInt int, location string, subscriptionID string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name            = "acctestRG-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, rInt, location, subscriptionID)
+}
+
+func testResourceAzureRMResourceGroup_defaultTags(rInt int, location string, owner string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    owner = "%s"
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+`, owner, rInt, location)
+}
@@ -0,0 +1,118 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// These resources were originally created using the Riviera SDK, which didn't normalize the casing of
+// the ID returned from Azure - so v1 of their state uses the ID returned from the Azure SDK for Go.
+
+func resourceAzureRMResourceGroupMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Resource Group State v0; migrating to v1")
+		return migrateAzureRMRivieraStateV0toV1(is, "Resource Group", func(input string) (string, error) {
+			id, err := parseResourceGroupID(input)
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMSearchServiceMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Search Service State v0; migrating to v1")
+		return migrateAzureRMRivieraStateV0toV1(is, "Search Service", func(input string) (string, error) {
+			id, err := parseSearchServiceID(input)
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMSqlDatabaseMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM SQL Database State v0; migrating to v1")
+		return migrateAzureRMRivieraStateV0toV1(is, "SQL Database", func(input string) (string, error) {
+			id, err := parseSqlDatabaseID(input)
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMSqlFirewallRuleMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM SQL Firewall Rule State v0; migrating to v1")
+		return migrateAzureRMRivieraStateV0toV1(is, "SQL Firewall Rule", func(input string) (string, error) {
+			id, err := parseSqlFirewallRuleID(input)
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMSqlServerMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM SQL Server State v0; migrating to v1")
+		return migrateAzureRMRivieraStateV0toV1(is, "SQL Server", func(input string) (string, error) {
+			id, err := parseSqlServerID(input)
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateAzureRMRivieraStateV0toV1 replaces the ID with the normalized ID, as parsed and rebuilt by normalizeId.
+func migrateAzureRMRivieraStateV0toV1(is *terraform.InstanceState, resourceType string, normalizeId func(string) (string, error)) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM %s ID before Migration: %q", resourceType, is.ID)
+
+	id, err := normalizeId(is.ID)
+	if err != nil {
+		return is, err
+	}
+
+	is.ID = id
+	is.Attributes["id"] = is.ID
+
+	log.Printf("[DEBUG] ARM %s ID after State Migration: %q", resourceType, is.ID)
+
+	return is, nil
+}
@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMRivieraMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		MigrateState func(int, *terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
+		ID           string
+		Expected     string
+	}{
+		"resource_group_normalized": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMResourceGroupMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		"resource_group_casing": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMResourceGroupMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		"search_service_normalized": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSearchServiceMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Search/searchServices/search1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Search/searchServices/search1",
+		},
+		"search_service_casing": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSearchServiceMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Search/SearchServices/search1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Search/searchServices/search1",
+		},
+		"sql_database_normalized": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlDatabaseMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+		},
+		"sql_database_casing": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlDatabaseMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/Databases/database1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+		},
+		"sql_firewall_rule_normalized": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlFirewallRuleMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1",
+		},
+		"sql_firewall_rule_casing": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlFirewallRuleMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1",
+		},
+		"sql_server_normalized": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlServerMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+		},
+		"sql_server_casing": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMSqlServerMigrateState,
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.sql/servers/server1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID: tc.ID,
+			Attributes: map[string]string{
+				"id": tc.ID,
+			},
+		}
+		is, err := tc.MigrateState(tc.StateVersion, is, nil)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if is.ID != tc.Expected || is.Attributes["id"] != tc.Expected {
+			t.Fatalf("bad Migrate for %s: %s\n\n expected: %s", tn, is.ID, tc.Expected)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +20,12 @@ func resourceArmRoute() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,7 +70,10 @@ func resourceArmRoute() *schema.Resource {
 
 func resourceArmRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	routesClient := client.routesClient
+	routesClient := client.routesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	rtName := d.Get("route_table_name").(string)
@@ -89,7 +100,7 @@ func resourceArmRouteCreate(d *schema.ResourceData, meta interface{}) error {
 		RoutePropertiesFormat: &properties,
 	}
 
-	_, error := routesClient.CreateOrUpdate(resGroup, rtName, name, route, make(chan struct{}))
+	_, error := routesClient.CreateOrUpdate(resGroup, rtName, name, route, ctx.Done())
 	err := <-error
 	if err != nil {
 		return err
@@ -108,15 +119,15 @@ func resourceArmRouteCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
-	routesClient := meta.(*ArmClient).routesClient
+	routesClient := meta.(*ArmClient).routesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
 	resp, err := routesClient.Get(resGroup, rtName, routeName)
 	if err != nil {
@@ -142,20 +153,23 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	routesClient := client.routesClient
+	routesClient := client.routesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
 	azureRMLockByName(rtName, routeTableResourceName)
 	defer azureRMUnlockByName(rtName, routeTableResourceName)
 
-	_, error := routesClient.Delete(resGroup, rtName, routeName, make(chan struct{}))
+	_, error := routesClient.Delete(resGroup, rtName, routeName, ctx.Done())
 	err = <-error
 
 	return err
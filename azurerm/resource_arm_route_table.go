@@ -2,9 +2,11 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -23,6 +25,12 @@ func resourceArmRouteTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -84,14 +92,20 @@ func resourceArmRouteTable() *schema.Resource {
 
 func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	routeTablesClient := client.routeTablesClient
+	routeTablesClient := client.routeTablesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Route Table creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	routeSet := network.RouteTable{
 		Name:     &name,
@@ -112,8 +126,8 @@ func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	_, error := routeTablesClient.CreateOrUpdate(resGroup, name, routeSet, make(chan struct{}))
-	err := <-error
+	_, error := routeTablesClient.CreateOrUpdate(resGroup, name, routeSet, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -132,14 +146,14 @@ func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	routeTablesClient := meta.(*ArmClient).routeTablesClient
+	routeTablesClient := meta.(*ArmClient).routeTablesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRouteTableID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["routeTables"]
+	name := id.Name
 
 	resp, err := routeTablesClient.Get(resGroup, name, "")
 	if err != nil {
@@ -167,22 +181,25 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("subnets", subnets)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
-	routeTablesClient := meta.(*ArmClient).routeTablesClient
+	routeTablesClient := meta.(*ArmClient).routeTablesClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseRouteTableID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["routeTables"]
+	name := id.Name
 
-	_, error := routeTablesClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := routeTablesClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -2,14 +2,58 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_route_table", &resource.Sweeper{
+		Name: "azurerm_route_table",
+		F:    testSweepRouteTables,
+		Dependencies: []string{
+			"azurerm_virtual_network",
+		},
+	})
+}
+
+func testSweepRouteTables(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.routeTablesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Route Tables..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Route Tables: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, table := range *results.Value {
+				resources = append(resources, sweepableResource{table.ID, table.Name, table.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Route Table", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestResourceAzureRMRouteTableNextHopType_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -60,7 +104,7 @@ func TestResourceAzureRMRouteTableNextHopType_validation(t *testing.T) {
 
 func TestAccAzureRMRouteTable_basic(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRouteTable_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -80,7 +124,7 @@ func TestAccAzureRMRouteTable_basic(t *testing.T) {
 
 func TestAccAzureRMRouteTable_disappears(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMRouteTable_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -102,7 +146,7 @@ func TestAccAzureRMRouteTable_disappears(t *testing.T) {
 
 func TestAccAzureRMRouteTable_withTags(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMRouteTable_withTags(ri, testLocation())
 	postConfig := testAccAzureRMRouteTable_withTagsUpdate(ri, testLocation())
 
@@ -139,7 +183,7 @@ func TestAccAzureRMRouteTable_withTags(t *testing.T) {
 
 func TestAccAzureRMRouteTable_multipleRoutes(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMRouteTable_basic(ri, testLocation())
 	postConfig := testAccAzureRMRouteTable_multipleRoutes(ri, testLocation())
 
@@ -182,7 +226,7 @@ func testCheckAzureRMRouteTableExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for route table: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).routeTablesClient
+		conn := testAccProvider.Meta().(*ArmClient).routeTablesClient()
 
 		resp, err := conn.Get(resourceGroup, name, "")
 		if err != nil {
@@ -211,7 +255,7 @@ func testCheckAzureRMRouteTableDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for route table: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).routeTablesClient
+		conn := testAccProvider.Meta().(*ArmClient).routeTablesClient()
 
 		_, error := conn.Delete(resourceGroup, name, make(chan struct{}))
 		err := <-error
@@ -224,7 +268,7 @@ func testCheckAzureRMRouteTableDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMRouteTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).routeTablesClient
+	conn := testAccProvider.Meta().(*ArmClient).routeTablesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_route_table" {
@@ -3,11 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/search"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/jen20/riviera/search"
 )
 
 func resourceArmSearchService() *schema.Resource {
@@ -20,6 +21,15 @@ func resourceArmSearchService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		MigrateState:  resourceAzureRMSearchServiceMigrateState,
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -58,135 +68,145 @@ func resourceArmSearchService() *schema.Resource {
 }
 
 func resourceArmSearchServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
-
-	command := &search.CreateOrUpdateSearchService{
-		Name:              d.Get("name").(string),
-		Location:          d.Get("location").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		Tags:              *expandedTags,
-		Sku: search.Sku{
-			Name: d.Get("sku").(string),
-		},
-	}
+	client := meta.(*ArmClient).searchServicesClient()
 
-	if v, ok := d.GetOk("replica_count"); ok {
-		replica_count := v.(int)
-		command.ReplicaCount = &replica_count
-	}
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	skuName := d.Get("sku").(string)
 
-	if v, ok := d.GetOk("partition_count"); ok {
-		partition_count := v.(int)
-		command.PartitionCount = &partition_count
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
 	}
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = command
+	properties := search.ServiceProperties{}
 
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating Search Service: %+v", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating Search Service: %+v", createResponse.Error)
+	if v, ok := d.GetOk("replica_count"); ok {
+		replicaCount := int32(v.(int))
+		properties.ReplicaCount = &replicaCount
 	}
 
-	getSearchServiceCommand := &search.GetSearchService{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
+	if v, ok := d.GetOk("partition_count"); ok {
+		partitionCount := int32(v.(int))
+		properties.PartitionCount = &partitionCount
 	}
 
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = getSearchServiceCommand
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading Search Service: %+v", err)
+	parameters := search.Service{
+		Location: &location,
+		Tags:     expandTags(tags),
+		Sku: &search.Sku{
+			Name: search.SkuName(skuName),
+		},
+		ServiceProperties: &properties,
 	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading Search Service: %+v", readResponse.Error)
+
+	if _, err := client.CreateOrUpdate(resGroup, name, parameters, nil); err != nil {
+		return fmt.Errorf("Error creating Search Service %q (Resource Group %q): %+v", name, resGroup, err)
 	}
-	resp := readResponse.Parsed.(*search.GetSearchServiceResponse)
 
-	log.Printf("[DEBUG] Waiting for Search Service (%s) to become available", d.Get("name"))
+	log.Printf("[DEBUG] Waiting for Search Service %q (Resource Group %q) to become available", name, resGroup)
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"provisioning"},
-		Target:     []string{"succeeded"},
-		Refresh:    azureStateRefreshFunc(*resp.ID, client, getSearchServiceCommand),
-		Timeout:    60 * time.Minute,
+		Pending:    []string{string(search.Provisioning)},
+		Target:     []string{string(search.Succeeded)},
+		Refresh:    searchServiceStateRefreshFunc(client, resGroup, name),
+		Timeout:    createOrUpdateTimeout(d),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Search Service (%s) to become available: %+v", d.Get("name"), err)
+		return fmt.Errorf("Error waiting for Search Service %q (Resource Group %q) to become available: %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, name, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Search Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Search Service %q (Resource Group %q) ID", name, resGroup)
 	}
 
-	d.SetId(*resp.ID)
+	d.SetId(*read.ID)
 
 	return resourceArmSearchServiceRead(d, meta)
 }
 
 func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+	client := meta.(*ArmClient).searchServicesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseSearchServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["searchServices"]
+	name := id.Name
 
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &search.GetSearchService{}
-
-	readResponse, err := readRequest.Execute()
+	resp, err := client.Get(resGroup, name, nil)
 	if err != nil {
-		return fmt.Errorf("Error reading Search Service: %+v", err)
-	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading Search Service %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading Search Service: %+v", readResponse.Error)
-	}
+		if responseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Search Service %q was not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
 
-	resp := readResponse.Parsed.(*search.GetSearchServiceResponse)
+		return fmt.Errorf("Error reading Search Service %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(resp.Location))
-	d.Set("sku", string(resp.Sku.Name))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 
-	if resp.PartitionCount != nil {
-		d.Set("partition_count", resp.PartitionCount)
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
 	}
 
-	if resp.ReplicaCount != nil {
-		d.Set("replica_count", resp.ReplicaCount)
+	if props := resp.ServiceProperties; props != nil {
+		if props.PartitionCount != nil {
+			d.Set("partition_count", int(*props.PartitionCount))
+		}
+
+		if props.ReplicaCount != nil {
+			d.Set("replica_count", int(*props.ReplicaCount))
+		}
 	}
 
-	flattenAndSetTags(d, &resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmSearchServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &search.DeleteSearchService{}
+	client := meta.(*ArmClient).searchServicesClient()
 
-	deleteResponse, err := deleteRequest.Execute()
+	id, err := parseSearchServiceID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Search Service: %+v", err)
+		return err
 	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting Search Service: %+v", deleteResponse.Error)
+
+	resp, err := client.Delete(id.ResourceGroup, id.Name, nil)
+	if err != nil {
+		if responseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Search Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
 	return nil
 }
+
+func searchServiceStateRefreshFunc(client search.ServicesClient, resourceGroupName string, searchServiceName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(resourceGroupName, searchServiceName, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error polling for the status of Search Service %q (Resource Group %q): %+v", searchServiceName, resourceGroupName, err)
+		}
+
+		if resp.ServiceProperties == nil || resp.ServiceProperties.ProvisioningState == "" {
+			return resp, string(search.Provisioning), nil
+		}
+
+		return resp, strings.ToLower(string(resp.ServiceProperties.ProvisioningState)), nil
+	}
+}
@@ -2,17 +2,58 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/jen20/riviera/search"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_search_service", &resource.Sweeper{
+		Name: "azurerm_search_service",
+		F:    testSweepSearchServices,
+	})
+}
+
+func testSweepSearchServices(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.searchServicesClient()
+	resources := make([]sweepableResource, 0)
+
+	resourceGroups, err := acceptanceTestResourceGroups(armClient, region)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceGroup := range resourceGroups {
+		log.Printf("Retrieving the Search Services in Resource Group '%s'..", resourceGroup)
+		results, err := client.ListByResourceGroup(resourceGroup, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Search Services in Resource Group %q: %+v", resourceGroup, err)
+		}
+
+		if results.Value != nil {
+			for _, service := range *results.Value {
+				resources = append(resources, sweepableResource{service.ID, service.Name, service.Location})
+			}
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "Search Service", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name, nil)
+		return err
+	})
+}
+
 func TestAccAzureRMSearchService_basic(t *testing.T) {
 	resourceName := "azurerm_search_service.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSearchService_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +74,7 @@ func TestAccAzureRMSearchService_basic(t *testing.T) {
 
 func TestAccAzureRMSearchService_updateReplicaCountAndTags(t *testing.T) {
 	resourceName := "azurerm_search_service.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMSearchService_basic(ri, location)
 	postConfig := testAccAzureRMSearchService_updated(ri, location)
@@ -66,23 +107,25 @@ func TestAccAzureRMSearchService_updateReplicaCountAndTags(t *testing.T) {
 
 func testCheckAzureRMSearchServiceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-
+		// Ensure we have enough information in state to look up in API
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+		id, err := parseSearchServiceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &search.GetSearchService{}
+		client := testAccProvider.Meta().(*ArmClient).searchServicesClient()
 
-		readResponse, err := readRequest.Execute()
+		resp, err := client.Get(id.ResourceGroup, id.Name, nil)
 		if err != nil {
-			return fmt.Errorf("Bad: GetSearchService: %+v", err)
-		}
-		if !readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: GetSearchService: %+v", readResponse.Error)
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Search Service %q (resource group: %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on searchServicesClient: %+v", err)
 		}
 
 		return nil
@@ -90,24 +133,27 @@ func testCheckAzureRMSearchServiceExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMSearchServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+	client := testAccProvider.Meta().(*ArmClient).searchServicesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_search_service" {
 			continue
 		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &search.GetSearchService{}
-
-		readResponse, err := readRequest.Execute()
+		id, err := parseSearchServiceID(rs.Primary.ID)
 		if err != nil {
-			return fmt.Errorf("Bad: GetSearchService: %+v", err)
+			return err
 		}
 
-		if readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: Search Service still exists: %+v", readResponse.Error)
+		resp, err := client.Get(id.ResourceGroup, id.Name, nil)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
 		}
+
+		return fmt.Errorf("Search Service still exists:\n%#v", resp)
 	}
 
 	return nil
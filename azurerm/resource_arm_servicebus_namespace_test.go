@@ -2,15 +2,56 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_servicebus_namespace", &resource.Sweeper{
+		Name: "azurerm_servicebus_namespace",
+		F:    testSweepServiceBusNamespaces,
+	})
+}
+
+func testSweepServiceBusNamespaces(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.serviceBusNamespacesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the ServiceBus Namespaces..")
+	results, err := client.ListBySubscription()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing ServiceBus Namespaces: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, namespace := range *results.Value {
+				resources = append(resources, sweepableResource{namespace.ID, namespace.Name, namespace.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListBySubscriptionNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "ServiceBus Namespace", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMServiceBusNamespaceCapacity_validation(t *testing.T) {
 	cases := []struct {
 		Value    int
@@ -45,7 +86,7 @@ func TestAccAzureRMServiceBusNamespaceCapacity_validation(t *testing.T) {
 
 func TestAccAzureRMServiceBusNamespace_basic(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMServiceBusNamespace_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -65,7 +106,7 @@ func TestAccAzureRMServiceBusNamespace_basic(t *testing.T) {
 
 func TestAccAzureRMServiceBusNamespace_readDefaultKeys(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMServiceBusNamespace_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -94,7 +135,7 @@ func TestAccAzureRMServiceBusNamespace_readDefaultKeys(t *testing.T) {
 func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMServiceBusNamespaceNonStandardCasing(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -118,7 +159,7 @@ func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+	conn := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_servicebus_namespace" {
@@ -156,7 +197,7 @@ func testCheckAzureRMServiceBusNamespaceExists(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace: %s", namespaceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+		conn := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient()
 
 		resp, err := conn.Get(resourceGroup, namespaceName)
 		if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,12 @@ func resourceArmServiceBusQueue() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -99,7 +106,7 @@ func resourceArmServiceBusQueue() *schema.Resource {
 }
 
 func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusQueuesClient
+	client := meta.(*ArmClient).serviceBusQueuesClient()
 	log.Printf("[INFO] preparing arguments for AzureRM ServiceBus Queue creation/update.")
 
 	name := d.Get("name").(string)
@@ -141,7 +148,7 @@ func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interfa
 
 	// We need to retrieve the namespace because Premium namespace works differently from Basic and Standard,
 	// so it needs different rules applied to it.
-	namespace, nsErr := meta.(*ArmClient).serviceBusNamespacesClient.Get(resGroup, namespaceName)
+	namespace, nsErr := meta.(*ArmClient).serviceBusNamespacesClient().Get(resGroup, namespaceName)
 	if nsErr != nil {
 		return nsErr
 	}
@@ -177,15 +184,15 @@ func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusQueuesClient
+	client := meta.(*ArmClient).serviceBusQueuesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseServiceBusQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["queues"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Get(resGroup, namespaceName, name)
 	if err != nil {
@@ -221,7 +228,7 @@ func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) er
 	// If the queue is NOT in a premium namespace (ie. it is Basic or Standard) and partitioning is enabled
 	// then the max size returned by the API will be 16 times greater than the value set.
 	if *props.EnablePartitioning {
-		namespace, err := meta.(*ArmClient).serviceBusNamespacesClient.Get(resGroup, namespaceName)
+		namespace, err := meta.(*ArmClient).serviceBusNamespacesClient().Get(resGroup, namespaceName)
 		if err != nil {
 			return err
 		}
@@ -238,15 +245,15 @@ func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusQueuesClient
+	client := meta.(*ArmClient).serviceBusQueuesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseServiceBusQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["queues"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	_, err = client.Delete(resGroup, namespaceName, name)
 
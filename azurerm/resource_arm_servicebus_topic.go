@@ -3,11 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmServiceBusTopic() *schema.Resource {
@@ -20,6 +21,12 @@ func resourceArmServiceBusTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -112,7 +119,7 @@ func resourceArmServiceBusTopic() *schema.Resource {
 }
 
 func resourceArmServiceBusTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusTopicsClient
+	client := meta.(*ArmClient).serviceBusTopicsClient()
 	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Topic creation.")
 
 	name := d.Get("name").(string)
@@ -134,26 +141,26 @@ func resourceArmServiceBusTopicCreate(d *schema.ResourceData, meta interface{})
 		Location: &location,
 		TopicProperties: &servicebus.TopicProperties{
 			Status:                            servicebus.EntityStatus(status),
-			EnableBatchedOperations:           azure.Bool(enableBatchedOps),
-			EnableExpress:                     azure.Bool(enableExpress),
-			FilteringMessagesBeforePublishing: azure.Bool(enableFiltering),
-			EnablePartitioning:                azure.Bool(enablePartitioning),
-			MaxSizeInMegabytes:                azure.Int64(maxSize),
-			RequiresDuplicateDetection:        azure.Bool(requiresDuplicateDetection),
-			SupportOrdering:                   azure.Bool(supportOrdering),
+			EnableBatchedOperations:           to.BoolPtr(enableBatchedOps),
+			EnableExpress:                     to.BoolPtr(enableExpress),
+			FilteringMessagesBeforePublishing: to.BoolPtr(enableFiltering),
+			EnablePartitioning:                to.BoolPtr(enablePartitioning),
+			MaxSizeInMegabytes:                to.Int64Ptr(maxSize),
+			RequiresDuplicateDetection:        to.BoolPtr(requiresDuplicateDetection),
+			SupportOrdering:                   to.BoolPtr(supportOrdering),
 		},
 	}
 
 	if autoDeleteOnIdle := d.Get("auto_delete_on_idle").(string); autoDeleteOnIdle != "" {
-		parameters.TopicProperties.AutoDeleteOnIdle = azure.String(autoDeleteOnIdle)
+		parameters.TopicProperties.AutoDeleteOnIdle = to.StringPtr(autoDeleteOnIdle)
 	}
 
 	if defaultTTL := d.Get("default_message_ttl").(string); defaultTTL != "" {
-		parameters.TopicProperties.DefaultMessageTimeToLive = azure.String(defaultTTL)
+		parameters.TopicProperties.DefaultMessageTimeToLive = to.StringPtr(defaultTTL)
 	}
 
 	if duplicateWindow := d.Get("duplicate_detection_history_time_window").(string); duplicateWindow != "" {
-		parameters.TopicProperties.DuplicateDetectionHistoryTimeWindow = azure.String(duplicateWindow)
+		parameters.TopicProperties.DuplicateDetectionHistoryTimeWindow = to.StringPtr(duplicateWindow)
 	}
 
 	_, err := client.CreateOrUpdate(resGroup, namespaceName, name, parameters)
@@ -175,15 +182,15 @@ func resourceArmServiceBusTopicCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusTopicsClient
+	client := meta.(*ArmClient).serviceBusTopicsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseServiceBusTopicID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["topics"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Get(resGroup, namespaceName, name)
 	if err != nil {
@@ -220,7 +227,7 @@ func resourceArmServiceBusTopicRead(d *schema.ResourceData, meta interface{}) er
 	// if the topic is in a premium namespace and partitioning is enabled then the
 	// max size returned by the API will be 16 times greater than the value set
 	if *props.EnablePartitioning {
-		namespace, err := meta.(*ArmClient).serviceBusNamespacesClient.Get(resGroup, namespaceName)
+		namespace, err := meta.(*ArmClient).serviceBusNamespacesClient().Get(resGroup, namespaceName)
 		if err != nil {
 			return err
 		}
@@ -237,15 +244,15 @@ func resourceArmServiceBusTopicRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmServiceBusTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).serviceBusTopicsClient
+	client := meta.(*ArmClient).serviceBusTopicsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseServiceBusTopicID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["topics"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	_, err = client.Delete(resGroup, namespaceName, name)
 
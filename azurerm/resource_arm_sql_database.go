@@ -1,12 +1,15 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/sql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/jen20/riviera/azure"
-	"github.com/jen20/riviera/sql"
+	"github.com/satori/uuid"
 )
 
 func resourceArmSqlDatabase() *schema.Resource {
@@ -15,6 +18,17 @@ func resourceArmSqlDatabase() *schema.Resource {
 		Read:   resourceArmSqlDatabaseRead,
 		Update: resourceArmSqlDatabaseCreate,
 		Delete: resourceArmSqlDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmSqlDatabaseImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		MigrateState:  resourceAzureRMSqlDatabaseMigrateState,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -119,134 +133,187 @@ func resourceArmSqlDatabase() *schema.Resource {
 }
 
 func resourceArmSqlDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
-
-	command := &sql.CreateOrUpdateDatabase{
-		Name:              d.Get("name").(string),
-		Location:          d.Get("location").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ServerName:        d.Get("server_name").(string),
-		Tags:              *expandedTags,
-		CreateMode:        azure.String(d.Get("create_mode").(string)),
-	}
+	client := meta.(*ArmClient).sqlDatabasesClient()
 
-	if v, ok := d.GetOk("source_database_id"); ok {
-		command.SourceDatabaseID = azure.String(v.(string))
-	}
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
-	if v, ok := d.GetOk("edition"); ok {
-		command.Edition = azure.String(v.(string))
-	}
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
 
-	if v, ok := d.GetOk("collation"); ok {
-		command.Collation = azure.String(v.(string))
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
 	}
 
-	if v, ok := d.GetOk("max_size_bytes"); ok {
-		command.MaxSizeBytes = azure.String(v.(string))
+	properties, err := expandArmSqlDatabaseProperties(d)
+	if err != nil {
+		return err
 	}
 
-	if v, ok := d.GetOk("source_database_deletion_date"); ok {
-		command.SourceDatabaseDeletionDate = azure.String(v.(string))
+	parameters := sql.Database{
+		Location:           &location,
+		Tags:               expandTags(tags),
+		DatabaseProperties: properties,
 	}
 
-	if v, ok := d.GetOk("requested_service_objective_id"); ok {
-		command.RequestedServiceObjectiveID = azure.String(v.(string))
+	_, errChan := client.CreateOrUpdate(resGroup, serverName, name, parameters, ctx.Done())
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error creating SQL Database %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
 	}
 
-	if v, ok := d.GetOk("elastic_pool_name"); ok {
-		command.ElasticPoolName = azure.String(v.(string))
+	read, err := client.Get(resGroup, serverName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Database %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
 	}
-
-	if v, ok := d.GetOk("requested_service_objective_name"); ok {
-		command.RequestedServiceObjectiveName = azure.String(v.(string))
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read SQL Database %q (SQL Server %q / Resource Group %q) ID", name, serverName, resGroup)
 	}
 
-	if v, ok := d.GetOk("restore_point_in_time"); ok {
-		command.RestorePointInTime = azure.String(v.(string))
-	}
+	d.SetId(*read.ID)
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = command
+	return resourceArmSqlDatabaseRead(d, meta)
+}
 
-	createResponse, err := createRequest.Execute()
+func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabasesClient()
+
+	id, err := parseSqlDatabaseID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error creating SQL Database: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating SQL Database: %s", createResponse.Error)
+		return err
 	}
 
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &sql.GetDatabase{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ServerName:        d.Get("server_name").(string),
+	resp, err := client.Get(id.ResourceGroup, id.ServerName, id.Name, "")
+	if err != nil {
+		if responseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Database %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Database %q (SQL Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
 	}
 
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading SQL Database: %s", err)
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.ServerName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
 	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading SQL Database: %s", readResponse.Error)
+
+	if props := resp.DatabaseProperties; props != nil {
+		d.Set("edition", string(props.Edition))
+		d.Set("collation", props.Collation)
+		d.Set("max_size_bytes", props.MaxSizeBytes)
+		d.Set("requested_service_objective_name", string(props.RequestedServiceObjectiveName))
+		d.Set("elastic_pool_name", props.ElasticPoolName)
+		d.Set("default_secondary_location", props.DefaultSecondaryLocation)
+
+		if props.RequestedServiceObjectiveID != nil {
+			d.Set("requested_service_objective_id", props.RequestedServiceObjectiveID.String())
+		}
+
+		if props.CreationDate != nil {
+			d.Set("creation_date", props.CreationDate.String())
+		}
 	}
 
-	resp := readResponse.Parsed.(*sql.GetDatabaseResponse)
-	d.SetId(*resp.ID)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
-	return resourceArmSqlDatabaseRead(d, meta)
+	return nil
 }
 
-func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+// resourceArmSqlDatabaseImportState imports the SQL Database - which, since the mode it was created with
+// isn't returned by the API, is assumed to have been created with the `Default` mode.
+func resourceArmSqlDatabaseImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseSqlDatabaseID(d.Id()); err != nil {
+		return nil, err
+	}
 
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &sql.GetDatabase{}
+	d.Set("create_mode", "Default")
+	return []*schema.ResourceData{d}, nil
+}
 
-	readResponse, err := readRequest.Execute()
+func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabasesClient()
+
+	id, err := parseSqlDatabaseID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error reading SQL Database: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading SQL Database %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading SQL Database: %s", readResponse.Error)
+		return err
 	}
 
-	resp := readResponse.Parsed.(*sql.GetDatabaseResponse)
-
-	d.Set("name", resp.Name)
-	d.Set("creation_date", resp.CreationDate)
-	d.Set("default_secondary_location", resp.DefaultSecondaryLocation)
-	d.Set("elastic_pool_name", resp.ElasticPoolName)
+	resp, err := client.Delete(id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if responseWasNotFound(resp) {
+			return nil
+		}
 
-	flattenAndSetTags(d, resp.Tags)
+		return fmt.Errorf("Error deleting SQL Database %q (SQL Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
+	}
 
 	return nil
 }
 
-func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+func expandArmSqlDatabaseProperties(d *schema.ResourceData) (*sql.DatabaseProperties, error) {
+	properties := sql.DatabaseProperties{
+		CreateMode: sql.CreateMode(d.Get("create_mode").(string)),
+	}
 
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &sql.DeleteDatabase{}
+	if v, ok := d.GetOk("source_database_id"); ok {
+		sourceDatabaseID := v.(string)
+		properties.SourceDatabaseID = &sourceDatabaseID
+	}
 
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting SQL Database: %s", err)
+	if v, ok := d.GetOk("edition"); ok {
+		properties.Edition = sql.DatabaseEdition(v.(string))
 	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting SQL Database: %s", deleteResponse.Error)
+
+	if v, ok := d.GetOk("collation"); ok {
+		collation := v.(string)
+		properties.Collation = &collation
 	}
 
-	return nil
+	if v, ok := d.GetOk("max_size_bytes"); ok {
+		maxSizeBytes := v.(string)
+		properties.MaxSizeBytes = &maxSizeBytes
+	}
+
+	if v, ok := d.GetOk("source_database_deletion_date"); ok {
+		deletionDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `source_database_deletion_date` %q: %+v", v.(string), err)
+		}
+		properties.SourceDatabaseDeletionDate = &date.Time{Time: deletionDate}
+	}
+
+	if v, ok := d.GetOk("requested_service_objective_id"); ok {
+		objectiveID, err := uuid.FromString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `requested_service_objective_id` %q: %+v", v.(string), err)
+		}
+		properties.RequestedServiceObjectiveID = &objectiveID
+	}
+
+	if v, ok := d.GetOk("elastic_pool_name"); ok {
+		elasticPoolName := v.(string)
+		properties.ElasticPoolName = &elasticPoolName
+	}
+
+	if v, ok := d.GetOk("requested_service_objective_name"); ok {
+		properties.RequestedServiceObjectiveName = sql.ServiceObjectiveName(v.(string))
+	}
+
+	if v, ok := d.GetOk("restore_point_in_time"); ok {
+		restorePoint, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `restore_point_in_time` %q: %+v", v.(string), err)
+		}
+		properties.RestorePointInTime = &date.Time{Time: restorePoint}
+	}
+
+	return &properties, nil
 }
 
 func validateArmSqlDatabaseEdition(v interface{}, k string) (ws []string, errors []error) {
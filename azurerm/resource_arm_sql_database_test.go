@@ -2,13 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/jen20/riviera/sql"
 )
 
 func TestResourceAzureRMSqlDatabaseEdition_validation(t *testing.T) {
@@ -48,7 +47,7 @@ func TestResourceAzureRMSqlDatabaseEdition_validation(t *testing.T) {
 }
 
 func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSqlDatabase_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -67,7 +66,7 @@ func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 }
 
 func TestAccAzureRMSqlDatabase_elasticPool(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSqlDatabase_elasticPool(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -87,7 +86,7 @@ func TestAccAzureRMSqlDatabase_elasticPool(t *testing.T) {
 }
 
 func TestAccAzureRMSqlDatabase_withTags(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMSqlDatabase_withTags(ri, location)
 	postConfig := testAccAzureRMSqlDatabase_withTagsUpdate(ri, location)
@@ -118,7 +117,7 @@ func TestAccAzureRMSqlDatabase_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMSqlDatabase_dataWarehouse(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSqlDatabase_dataWarehouse(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -137,7 +136,7 @@ func TestAccAzureRMSqlDatabase_dataWarehouse(t *testing.T) {
 }
 
 func TestAccAzureRMSqlDatabase_restorePointInTime(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMSqlDatabase_basic(ri, location)
 	timeToRestore := time.Now().Add(15 * time.Minute)
@@ -170,23 +169,25 @@ func TestAccAzureRMSqlDatabase_restorePointInTime(t *testing.T) {
 
 func testCheckAzureRMSqlDatabaseExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-
+		// Ensure we have enough information in state to look up in API
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+		id, err := parseSqlDatabaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetDatabase{}
+		client := testAccProvider.Meta().(*ArmClient).sqlDatabasesClient()
 
-		readResponse, err := readRequest.Execute()
+		resp, err := client.Get(id.ResourceGroup, id.ServerName, id.Name, "")
 		if err != nil {
-			return fmt.Errorf("Bad: GetDatabase: %s", err)
-		}
-		if !readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: GetDatabase: %s", readResponse.Error)
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: SQL Database %q (resource group: %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on sqlDatabasesClient: %+v", err)
 		}
 
 		return nil
@@ -194,24 +195,27 @@ func testCheckAzureRMSqlDatabaseExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMSqlDatabaseDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+	client := testAccProvider.Meta().(*ArmClient).sqlDatabasesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_sql_database" {
 			continue
 		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetDatabase{}
-
-		readResponse, err := readRequest.Execute()
+		id, err := parseSqlDatabaseID(rs.Primary.ID)
 		if err != nil {
-			return fmt.Errorf("Bad: GetDatabase: %s", err)
+			return err
 		}
 
-		if readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: SQL Database still exists: %s", readResponse.Error)
+		resp, err := client.Get(id.ResourceGroup, id.ServerName, id.Name, "")
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
 		}
+
+		return fmt.Errorf("SQL Database still exists:\n%#v", resp)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +17,11 @@ func resourceArmSqlElasticPool() *schema.Resource {
 		Read:   resourceArmSqlElasticPoolRead,
 		Update: resourceArmSqlElasticPoolCreate,
 		Delete: resourceArmSqlElasticPoolDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -84,7 +90,10 @@ func resourceArmSqlElasticPool() *schema.Resource {
 
 func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	elasticPoolsClient := client.sqlElasticPoolsClient
+	elasticPoolsClient := client.sqlElasticPoolsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM SQL ElasticPool creation.")
 
@@ -92,7 +101,10 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 	serverName := d.Get("server_name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	elasticPool := sql.ElasticPool{
 		Name:                  &name,
@@ -101,8 +113,8 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
-	_, error := elasticPoolsClient.CreateOrUpdate(resGroup, serverName, name, elasticPool, make(chan struct{}))
-	err := <-error
+	_, error := elasticPoolsClient.CreateOrUpdate(resGroup, serverName, name, elasticPool, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -122,7 +134,7 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	elasticPoolsClient := client.sqlElasticPoolsClient
+	elasticPoolsClient := client.sqlElasticPoolsClient()
 
 	resGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
 	if err != nil {
@@ -157,14 +169,14 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	elasticPoolsClient := client.sqlElasticPoolsClient
+	elasticPoolsClient := client.sqlElasticPoolsClient()
 
 	resGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
 	if err != nil {
@@ -204,12 +216,12 @@ func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProp
 }
 
 func parseArmSqlElasticPoolId(sqlElasticPoolId string) (string, string, string, error) {
-	id, err := parseAzureResourceID(sqlElasticPoolId)
+	id, err := parseSqlElasticPoolID(sqlElasticPoolId)
 	if err != nil {
 		return "", "", "", fmt.Errorf("[ERROR] Unable to parse SQL ElasticPool ID '%s': %+v", sqlElasticPoolId, err)
 	}
 
-	return id.ResourceGroup, id.Path["servers"], id.Path["elasticPools"], nil
+	return id.ResourceGroup, id.ServerName, id.Name, nil
 }
 
 func validateSqlElasticPoolEdition() schema.SchemaValidateFunc {
@@ -2,17 +2,16 @@ package azurerm
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/jen20/riviera/sql"
 )
 
 func TestAccAzureRMSqlFirewallRule_basic(t *testing.T) {
 	resourceName := "azurerm_sql_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMSqlFirewallRule_basic(ri, testLocation())
 	postConfig := testAccAzureRMSqlFirewallRule_withUpdates(ri, testLocation())
 
@@ -43,23 +42,25 @@ func TestAccAzureRMSqlFirewallRule_basic(t *testing.T) {
 
 func testCheckAzureRMSqlFirewallRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-
+		// Ensure we have enough information in state to look up in API
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+		id, err := parseSqlFirewallRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetFirewallRule{}
+		client := testAccProvider.Meta().(*ArmClient).sqlFirewallRulesClient()
 
-		readResponse, err := readRequest.Execute()
+		resp, err := client.Get(id.ResourceGroup, id.ServerName, id.Name)
 		if err != nil {
-			return fmt.Errorf("Bad: GetFirewallRule: %+v", err)
-		}
-		if !readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: GetFirewallRule: %+v", readResponse.Error)
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: SQL Firewall Rule %q (resource group: %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on sqlFirewallRulesClient: %+v", err)
 		}
 
 		return nil
@@ -67,24 +68,27 @@ func testCheckAzureRMSqlFirewallRuleExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMSqlFirewallRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+	client := testAccProvider.Meta().(*ArmClient).sqlFirewallRulesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_sql_firewall_rule" {
 			continue
 		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetFirewallRule{}
-
-		readResponse, err := readRequest.Execute()
+		id, err := parseSqlFirewallRuleID(rs.Primary.ID)
 		if err != nil {
-			return fmt.Errorf("Bad: GetFirewallRule: %+v", err)
+			return err
 		}
 
-		if readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: SQL Server Firewall Rule still exists: %+v", readResponse.Error)
+		resp, err := client.Get(id.ResourceGroup, id.ServerName, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
 		}
+
+		return fmt.Errorf("SQL Firewall Rule still exists:\n%#v", resp)
 	}
 
 	return nil
@@ -3,10 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/sql"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/jen20/riviera/azure"
-	"github.com/jen20/riviera/sql"
 )
 
 func resourceArmSqlServer() *schema.Resource {
@@ -19,6 +19,15 @@ func resourceArmSqlServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		MigrateState:  resourceAzureRMSqlServerMigrateState,
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -61,100 +70,96 @@ func resourceArmSqlServer() *schema.Resource {
 }
 
 func resourceArmSqlServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
-
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = &sql.CreateOrUpdateServer{
-		Name:                       d.Get("name").(string),
-		Location:                   d.Get("location").(string),
-		ResourceGroupName:          d.Get("resource_group_name").(string),
-		AdministratorLogin:         azure.String(d.Get("administrator_login").(string)),
-		AdministratorLoginPassword: azure.String(d.Get("administrator_login_password").(string)),
-		Version:                    azure.String(d.Get("version").(string)),
-		Tags:                       *expandedTags,
-	}
+	client := meta.(*ArmClient).sqlServersClient()
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	adminUsername := d.Get("administrator_login").(string)
+	adminPassword := d.Get("administrator_login_password").(string)
+	version := d.Get("version").(string)
 
-	createResponse, err := createRequest.Execute()
+	tags, err := mergeDefaultTags(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating SQL Server: %s", err)
+		return err
 	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating SQL Server: %s", createResponse.Error)
+
+	parameters := sql.Server{
+		Location: &location,
+		Tags:     expandTags(tags),
+		ServerProperties: &sql.ServerProperties{
+			Version:                    sql.ServerVersion(version),
+			AdministratorLogin:         &adminUsername,
+			AdministratorLoginPassword: &adminPassword,
+		},
 	}
 
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &sql.GetServer{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
+	if _, err := client.CreateOrUpdate(resGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	readResponse, err := readRequest.Execute()
+	read, err := client.Get(resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error reading SQL Server: %s", err)
+		return fmt.Errorf("Error retrieving SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
 	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading SQL Server: %s", readResponse.Error)
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read SQL Server %q (Resource Group %q) ID", name, resGroup)
 	}
 
-	resp := readResponse.Parsed.(*sql.GetServerResponse)
-	d.SetId(*resp.ID)
+	d.SetId(*read.ID)
 
 	return resourceArmSqlServerRead(d, meta)
 }
 
 func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
+	client := meta.(*ArmClient).sqlServersClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseSqlServerID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &sql.GetServer{}
-
-	readResponse, err := readRequest.Execute()
+	resp, err := client.Get(id.ResourceGroup, id.Name)
 	if err != nil {
-		return fmt.Errorf("Error reading SQL Server: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading SQL Server %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading SQL Server: %s", readResponse.Error)
-	}
+		if responseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Server %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
 
-	resp := readResponse.Parsed.(*sql.GetServerResponse)
+		return fmt.Errorf("Error reading SQL Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
 
-	d.Set("name", id.Path["servers"])
+	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	d.Set("fully_qualified_domain_name", resp.FullyQualifiedDomainName)
-	d.Set("administrator_login", resp.AdministratorLogin)
-	d.Set("version", resp.Version)
 
-	flattenAndSetTags(d, resp.Tags)
+	if props := resp.ServerProperties; props != nil {
+		d.Set("fully_qualified_domain_name", props.FullyQualifiedDomainName)
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("version", string(props.Version))
+	}
+
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &sql.DeleteServer{}
+	client := meta.(*ArmClient).sqlServersClient()
 
-	deleteResponse, err := deleteRequest.Execute()
+	id, err := parseSqlServerID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting SQL Server: %s", err)
+		return err
 	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting SQL Server: %s", deleteResponse.Error)
+
+	resp, err := client.Delete(id.ResourceGroup, id.Name)
+	if err != nil {
+		if responseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
 	return nil
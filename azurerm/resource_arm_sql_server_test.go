@@ -2,16 +2,50 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/jen20/riviera/sql"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_sql_server", &resource.Sweeper{
+		Name: "azurerm_sql_server",
+		F:    testSweepSQLServers,
+	})
+}
+
+func testSweepSQLServers(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.sqlServersClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the SQL Servers..")
+	results, err := client.List()
+	if err != nil {
+		return fmt.Errorf("Error listing SQL Servers: %+v", err)
+	}
+
+	if results.Value != nil {
+		for _, server := range *results.Value {
+			resources = append(resources, sweepableResource{server.ID, server.Name, server.Location})
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "SQL Server", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMSqlServer_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMSqlServer_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +65,7 @@ func TestAccAzureRMSqlServer_basic(t *testing.T) {
 
 func TestAccAzureRMSqlServer_withTags(t *testing.T) {
 	resourceName := "azurerm_sql_server.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMSqlServer_withTags(ri, location)
 	postConfig := testAccAzureRMSqlServer_withTagsUpdated(ri, location)
@@ -61,23 +95,25 @@ func TestAccAzureRMSqlServer_withTags(t *testing.T) {
 
 func testCheckAzureRMSqlServerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-
+		// Ensure we have enough information in state to look up in API
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+		id, err := parseSqlServerID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetServer{}
+		client := testAccProvider.Meta().(*ArmClient).sqlServersClient()
 
-		readResponse, err := readRequest.Execute()
+		resp, err := client.Get(id.ResourceGroup, id.Name)
 		if err != nil {
-			return fmt.Errorf("Bad: GetServer: %+v", err)
-		}
-		if !readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: GetServer: %+v", readResponse.Error)
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: SQL Server %q (resource group: %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on sqlServersClient: %+v", err)
 		}
 
 		return nil
@@ -85,24 +121,27 @@ func testCheckAzureRMSqlServerExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMSqlServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+	client := testAccProvider.Meta().(*ArmClient).sqlServersClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_sql_server" {
 			continue
 		}
 
-		readRequest := conn.NewRequestForURI(rs.Primary.ID)
-		readRequest.Command = &sql.GetServer{}
-
-		readResponse, err := readRequest.Execute()
+		id, err := parseSqlServerID(rs.Primary.ID)
 		if err != nil {
-			return fmt.Errorf("Bad: GetServer: % +v", err)
+			return err
 		}
 
-		if readResponse.IsSuccessful() {
-			return fmt.Errorf("Bad: SQL Server still exists: %+v", readResponse.Error)
+		resp, err := client.Get(id.ResourceGroup, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
 		}
+
+		return fmt.Errorf("SQL Server still exists:\n%#v", resp)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -29,6 +30,12 @@ func resourceArmStorageAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -158,7 +165,10 @@ func resourceArmStorageAccount() *schema.Resource {
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	storageClient := client.storageServiceClient
+	storageClient := client.storageServiceClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("name").(string)
@@ -166,7 +176,10 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	accountType := d.Get("account_type").(string)
 
 	location := d.Get("location").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	enableBlobEncryption := d.Get("enable_blob_encryption").(bool)
 	enableHTTPSTrafficOnly := d.Get("enable_https_traffic_only").(bool)
 
@@ -204,7 +217,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	_, createError := storageClient.Create(resourceGroupName, storageAccountName, opts, make(chan struct{}))
+	_, createError := storageClient.Create(resourceGroupName, storageAccountName, opts, ctx.Done())
 	createErr := <-createError
 
 	// The only way to get the ID back apparently is to read the resource again
@@ -240,7 +253,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    storageAccountStateRefreshFunc(client, resourceGroupName, storageAccountName),
-		Timeout:    30 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -254,12 +267,12 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).storageServiceClient
-	id, err := parseAzureResourceID(d.Id())
+	client := meta.(*ArmClient).storageServiceClient()
+	id, err := parseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageAccounts"]
+	storageAccountName := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	d.Partial(true)
@@ -298,18 +311,22 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("access_tier")
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]interface{})
+	if d.HasChange("tags") || d.HasChange("outdated_tags") {
+		tags, err := mergeDefaultTags(d, meta)
+		if err != nil {
+			return err
+		}
 
 		opts := storage.AccountUpdateParameters{
 			Tags: expandTags(tags),
 		}
-		_, err := client.Update(resourceGroupName, storageAccountName, opts)
+		_, err = client.Update(resourceGroupName, storageAccountName, opts)
 		if err != nil {
 			return fmt.Errorf("Error updating Azure Storage Account tags %q: %s", storageAccountName, err)
 		}
 
 		d.SetPartial("tags")
+		d.SetPartial("outdated_tags")
 	}
 
 	if d.HasChange("enable_blob_encryption") {
@@ -356,13 +373,13 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).storageServiceClient
+	client := meta.(*ArmClient).storageServiceClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(resGroup, name)
@@ -435,19 +452,19 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", resp.Name)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).storageServiceClient
+	client := meta.(*ArmClient).storageServiceClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	_, err = client.Delete(resGroup, name)
@@ -486,7 +503,7 @@ func validateArmStorageAccountType(v interface{}, k string) (ws []string, es []e
 
 func storageAccountStateRefreshFunc(client *ArmClient, resourceGroupName string, storageAccountName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.storageServiceClient.GetProperties(resourceGroupName, storageAccountName)
+		res, err := client.storageServiceClient().GetProperties(resourceGroupName, storageAccountName)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in storageAccountStateRefreshFunc to Azure ARM for Storage Account '%s' (RG: '%s'): %s", storageAccountName, resourceGroupName, err)
 		}
@@ -2,14 +2,53 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_storage_account", &resource.Sweeper{
+		Name: "azurerm_storage_account",
+		F:    testSweepStorageAccounts,
+		Dependencies: []string{
+			"azurerm_image",
+			"azurerm_virtual_machine",
+			"azurerm_virtual_machine_scale_set",
+		},
+	})
+}
+
+func testSweepStorageAccounts(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.storageServiceClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Storage Accounts..")
+	results, err := client.List()
+	if err != nil {
+		return fmt.Errorf("Error listing Storage Accounts: %+v", err)
+	}
+
+	if results.Value != nil {
+		for _, account := range *results.Value {
+			resources = append(resources, sweepableResource{account.ID, account.Name, account.Location})
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "Storage Account", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestValidateArmStorageAccountType(t *testing.T) {
 	testCases := []struct {
 		input       string
@@ -52,8 +91,8 @@ func TestValidateArmStorageAccountName(t *testing.T) {
 
 func TestAccAzureRMStorageAccount_basic(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_update(ri, rs, location)
@@ -88,8 +127,8 @@ func TestAccAzureRMStorageAccount_basic(t *testing.T) {
 
 func TestAccAzureRMStorageAccount_disappears(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -113,8 +152,8 @@ func TestAccAzureRMStorageAccount_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMStorageAccount_blobConnectionString(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -134,8 +173,8 @@ func TestAccAzureRMStorageAccount_blobConnectionString(t *testing.T) {
 }
 
 func TestAccAzureRMStorageAccount_blobEncryption(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_blobEncryption(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_blobEncryptionDisabled(ri, rs, location)
@@ -165,8 +204,8 @@ func TestAccAzureRMStorageAccount_blobEncryption(t *testing.T) {
 }
 
 func TestAccAzureRMStorageAccount_enableHttpsTrafficOnly(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_enableHttpsTrafficOnly(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_enableHttpsTrafficOnlyDisabled(ri, rs, location)
@@ -196,8 +235,8 @@ func TestAccAzureRMStorageAccount_enableHttpsTrafficOnly(t *testing.T) {
 }
 
 func TestAccAzureRMStorageAccount_blobStorageWithUpdate(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_blobStorage(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_blobStorageUpdate(ri, rs, location)
@@ -228,8 +267,8 @@ func TestAccAzureRMStorageAccount_blobStorageWithUpdate(t *testing.T) {
 }
 
 func TestAccAzureRMStorageAccount_NonStandardCasing(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
 	preConfig := testAccAzureRMStorageAccountNonStandardCasing(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -253,6 +292,56 @@ func TestAccAzureRMStorageAccount_NonStandardCasing(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMStorageAccount_fakeArm(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 4)
+	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, "West US")
+	postConfig := testAccAzureRMStorageAccount_update(ri, rs, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.longRunning("Microsoft.Storage/storageAccounts", http.MethodPut)
+	server.handleAction("Microsoft.Storage/storageAccounts", "listKeys", func(account map[string]interface{}) interface{} {
+		return map[string]interface{}{
+			"keys": []map[string]interface{}{
+				{"keyName": "key1", "value": "cHJpbWFyeQ==", "permissions": "Full"},
+				{"keyName": "key2", "value": "c2Vjb25kYXJ5", "permissions": "Full"},
+			},
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_storage_account"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_type", "Standard_LRS"),
+					resource.TestCheckResourceAttr(resourceName, "primary_access_key", "cHJpbWFyeQ=="),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_type", "Standard_GRS"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageAccountExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -265,7 +354,7 @@ func testCheckAzureRMStorageAccountExists(name string) resource.TestCheckFunc {
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
 		// Ensure resource group exists in API
-		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient()
 
 		resp, err := conn.GetProperties(resourceGroup, storageAccount)
 		if err != nil {
@@ -292,7 +381,7 @@ func testCheckAzureRMStorageAccountDisappears(name string) resource.TestCheckFun
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
 		// Ensure resource group exists in API
-		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient()
 
 		_, err := conn.Delete(resourceGroup, storageAccount)
 		if err != nil {
@@ -304,7 +393,7 @@ func testCheckAzureRMStorageAccountDisappears(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMStorageAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+	conn := testAccProvider.Meta().(*ArmClient).storageServiceClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_storage_account" {
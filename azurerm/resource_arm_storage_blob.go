@@ -11,6 +11,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -22,6 +23,15 @@ func resourceArmStorageBlob() *schema.Resource {
 		Read:   resourceArmStorageBlobRead,
 		Exists: resourceArmStorageBlobExists,
 		Delete: resourceArmStorageBlobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageBlobImportState,
+		},
+		MigrateState:  resourceAzureRMStorageBlobMigrateState,
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -204,7 +214,14 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	d.SetId(name)
+	id := StorageBlobID{
+		SubscriptionID:       armClient.subscriptionId,
+		ResourceGroup:        resourceGroupName,
+		StorageAccountName:   storageAccountName,
+		StorageContainerName: cont,
+		Name:                 name,
+	}
+	d.SetId(id.String())
 	return resourceArmStorageBlobRead(d, meta)
 }
 
@@ -530,8 +547,12 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageBlobID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -553,8 +574,13 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	name := d.Get("name").(string)
-	storageContainerName := d.Get("storage_container_name").(string)
+	name := id.Name
+	storageContainerName := id.StorageContainerName
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroupName)
+	d.Set("storage_account_name", storageAccountName)
+	d.Set("storage_container_name", storageContainerName)
 
 	container := blobClient.GetContainerReference(storageContainerName)
 	blob := container.GetBlobReference(name)
@@ -567,11 +593,52 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceArmStorageBlobImportState imports the Blob, along with its type and size. The source of the Blob
+// isn't available, and the fields which only affect how it's uploaded are set to their defaults.
+func resourceArmStorageBlobImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	armClient := meta.(*ArmClient)
+
+	id, err := parseStorageBlobID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Storage Account %q Not Found", id.StorageAccountName)
+	}
+
+	blob := blobClient.GetContainerReference(id.StorageContainerName).GetBlobReference(id.Name)
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return nil, fmt.Errorf("Error retrieving the properties of storage blob %q: %s", id.Name, err)
+	}
+
+	d.Set("type", strings.ToLower(strings.TrimSuffix(string(blob.Properties.BlobType), "Blob")))
+
+	// the size is only specified for Page Blobs
+	if blob.Properties.BlobType == storage.BlobTypePage {
+		d.Set("size", int(blob.Properties.ContentLength))
+	} else {
+		d.Set("size", 0)
+	}
+	d.Set("parallelism", 8)
+	d.Set("attempts", 1)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceArmStorageBlobExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageBlobID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -583,8 +650,8 @@ func resourceArmStorageBlobExists(d *schema.ResourceData, meta interface{}) (boo
 		return false, nil
 	}
 
-	name := d.Get("name").(string)
-	storageContainerName := d.Get("storage_container_name").(string)
+	name := id.Name
+	storageContainerName := id.StorageContainerName
 
 	log.Printf("[INFO] Checking for existence of storage blob %q.", name)
 	container := blobClient.GetContainerReference(storageContainerName)
@@ -605,8 +672,12 @@ func resourceArmStorageBlobExists(d *schema.ResourceData, meta interface{}) (boo
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageBlobID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -617,8 +688,8 @@ func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
-	name := d.Get("name").(string)
-	storageContainerName := d.Get("storage_container_name").(string)
+	name := id.Name
+	storageContainerName := id.StorageContainerName
 
 	log.Printf("[INFO] Deleting storage blob %q", name)
 	options := &storage.DeleteBlobOptions{}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"regexp"
 
@@ -17,6 +18,16 @@ func resourceArmStorageContainer() *schema.Resource {
 		Read:   resourceArmStorageContainerRead,
 		Exists: resourceArmStorageContainerExists,
 		Delete: resourceArmStorageContainerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		MigrateState:  resourceAzureRMStorageContainerMigrateState,
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(90 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,7 +135,13 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting permissions for container %s in storage account %s: %+v", name, storageAccountName, err)
 	}
 
-	d.SetId(name)
+	id := StorageContainerID{
+		SubscriptionID:     armClient.subscriptionId,
+		ResourceGroup:      resourceGroupName,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+	d.SetId(id.String())
 	return resourceArmStorageContainerRead(d, meta)
 }
 
@@ -133,8 +150,12 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -146,10 +167,10 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 	containers, err := blobClient.ListContainers(storage.ListContainersParameters{
 		Prefix:  name,
-		Timeout: 90,
+		Timeout: uint(d.Timeout(schema.TimeoutRead).Seconds()),
 	})
 	if err != nil {
 		return fmt.Errorf("Failed to retrieve storage containers in account %q: %s", name, err)
@@ -160,6 +181,10 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 		if cont.Name == name {
 			found = true
 
+			d.Set("name", name)
+			d.Set("resource_group_name", resourceGroupName)
+			d.Set("storage_account_name", storageAccountName)
+
 			props := make(map[string]interface{})
 			props["last_modified"] = cont.Properties.LastModified
 			props["lease_status"] = cont.Properties.LeaseStatus
@@ -173,16 +198,32 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 	if !found {
 		log.Printf("[INFO] Storage container %q does not exist in account %q, removing from state...", name, storageAccountName)
 		d.SetId("")
+		return nil
+	}
+
+	permissions, err := blobClient.GetContainerReference(name).GetPermissions(&storage.GetContainerPermissionOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving permissions for container %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
+	accessType := "private"
+	if permissions.AccessType != "" {
+		accessType = string(permissions.AccessType)
+	}
+	d.Set("container_access_type", accessType)
+
 	return nil
 }
 
 func resourceArmStorageContainerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageContainerID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -194,7 +235,7 @@ func resourceArmStorageContainerExists(d *schema.ResourceData, meta interface{})
 		return false, nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	log.Printf("[INFO] Checking existence of storage container %q in storage account %q", name, storageAccountName)
 	reference := blobClient.GetContainerReference(name)
@@ -216,8 +257,12 @@ func resourceArmStorageContainerExists(d *schema.ResourceData, meta interface{})
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -228,7 +273,7 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	log.Printf("[INFO] Deleting storage container %q in account %q", name, storageAccountName)
 	reference := blobClient.GetContainerReference(name)
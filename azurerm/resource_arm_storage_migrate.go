@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The objects within a Storage Account were originally identified by just their name, which isn't unique
+// (and can't be imported) - so v1 of their state uses an ID containing the Storage Account and Resource Group.
+
+func resourceAzureRMStorageBlobMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Storage Blob State v0; migrating to v1")
+		return migrateAzureRMStorageStateV0toV1(is, meta, "Storage Blob", func(subscriptionId string) string {
+			return StorageBlobID{
+				SubscriptionID:       subscriptionId,
+				ResourceGroup:        is.Attributes["resource_group_name"],
+				StorageAccountName:   is.Attributes["storage_account_name"],
+				StorageContainerName: is.Attributes["storage_container_name"],
+				Name:                 is.Attributes["name"],
+			}.String()
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMStorageContainerMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Storage Container State v0; migrating to v1")
+		return migrateAzureRMStorageStateV0toV1(is, meta, "Storage Container", func(subscriptionId string) string {
+			return StorageContainerID{
+				SubscriptionID:     subscriptionId,
+				ResourceGroup:      is.Attributes["resource_group_name"],
+				StorageAccountName: is.Attributes["storage_account_name"],
+				Name:               is.Attributes["name"],
+			}.String()
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMStorageQueueMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Storage Queue State v0; migrating to v1")
+		return migrateAzureRMStorageStateV0toV1(is, meta, "Storage Queue", func(subscriptionId string) string {
+			return StorageQueueID{
+				SubscriptionID:     subscriptionId,
+				ResourceGroup:      is.Attributes["resource_group_name"],
+				StorageAccountName: is.Attributes["storage_account_name"],
+				Name:               is.Attributes["name"],
+			}.String()
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMStorageShareMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Storage Share State v0; migrating to v1")
+		return migrateAzureRMStorageStateV0toV1(is, meta, "Storage Share", func(subscriptionId string) string {
+			return StorageShareID{
+				SubscriptionID:     subscriptionId,
+				ResourceGroup:      is.Attributes["resource_group_name"],
+				StorageAccountName: is.Attributes["storage_account_name"],
+				Name:               is.Attributes["name"],
+			}.String()
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func resourceAzureRMStorageTableMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Storage Table State v0; migrating to v1")
+		return migrateAzureRMStorageStateV0toV1(is, meta, "Storage Table", func(subscriptionId string) string {
+			return StorageTableID{
+				SubscriptionID:     subscriptionId,
+				ResourceGroup:      is.Attributes["resource_group_name"],
+				StorageAccountName: is.Attributes["storage_account_name"],
+				Name:               is.Attributes["name"],
+			}.String()
+		})
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateAzureRMStorageStateV0toV1 replaces the name used as the ID with the ID built from the attributes,
+// in the Subscription the Storage Account is in.
+func migrateAzureRMStorageStateV0toV1(is *terraform.InstanceState, meta interface{}, resourceType string, buildId func(subscriptionId string) string) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM %s ID before Migration: %q", resourceType, is.ID)
+
+	subscriptionId := is.Attributes[subscriptionIDKey]
+	if subscriptionId == "" {
+		subscriptionId = meta.(*ArmClient).subscriptionId
+	}
+
+	is.ID = buildId(subscriptionId)
+	is.Attributes["id"] = is.ID
+
+	log.Printf("[DEBUG] ARM %s ID after State Migration: %q", resourceType, is.ID)
+
+	return is, nil
+}
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMStorageMigrateState(t *testing.T) {
+	meta := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+	}
+
+	cases := map[string]struct {
+		StateVersion int
+		MigrateState func(int, *terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
+		Attributes   map[string]string
+		Expected     string
+	}{
+		"blob": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageBlobMigrateState,
+			Attributes: map[string]string{
+				"name":                   "images/disk1.vhd",
+				"resource_group_name":    "group1",
+				"storage_account_name":   "account1",
+				"storage_container_name": "vhds",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds/blobs/images/disk1.vhd",
+		},
+		"container": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageContainerMigrateState,
+			Attributes: map[string]string{
+				"name":                 "vhds",
+				"resource_group_name":  "group1",
+				"storage_account_name": "account1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds",
+		},
+		"container_other_subscription": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageContainerMigrateState,
+			Attributes: map[string]string{
+				"name":                 "vhds",
+				"resource_group_name":  "group1",
+				"storage_account_name": "account1",
+				"subscription_id":      "11111111-1111-1111-1111-111111111111",
+			},
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds",
+		},
+		"queue": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageQueueMigrateState,
+			Attributes: map[string]string{
+				"name":                 "queue1",
+				"resource_group_name":  "group1",
+				"storage_account_name": "account1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/queueServices/default/queues/queue1",
+		},
+		"share": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageShareMigrateState,
+			Attributes: map[string]string{
+				"name":                 "share1",
+				"resource_group_name":  "group1",
+				"storage_account_name": "account1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/fileServices/default/shares/share1",
+		},
+		"table": {
+			StateVersion: 0,
+			MigrateState: resourceAzureRMStorageTableMigrateState,
+			Attributes: map[string]string{
+				"name":                 "table1",
+				"resource_group_name":  "group1",
+				"storage_account_name": "account1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/tableServices/default/tables/table1",
+		},
+	}
+
+	for tn, tc := range cases {
+		tc.Attributes["id"] = tc.Attributes["name"]
+		is := &terraform.InstanceState{
+			ID:         tc.Attributes["name"],
+			Attributes: tc.Attributes,
+		}
+		is, err := tc.MigrateState(tc.StateVersion, is, meta)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if is.ID != tc.Expected || is.Attributes["id"] != tc.Expected {
+			t.Fatalf("bad Storage Migrate: %s\n\n expected: %s", is.ID, tc.Expected)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -15,6 +16,15 @@ func resourceArmStorageQueue() *schema.Resource {
 		Read:   resourceArmStorageQueueRead,
 		Exists: resourceArmStorageQueueExists,
 		Delete: resourceArmStorageQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		MigrateState:  resourceAzureRMStorageQueueMigrateState,
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -90,11 +100,21 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating storage queue on Azure: %s", err)
 	}
 
-	d.SetId(name)
+	id := StorageQueueID{
+		SubscriptionID:     armClient.subscriptionId,
+		ResourceGroup:      resourceGroupName,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+	d.SetId(id.String())
 	return resourceArmStorageQueueRead(d, meta)
 }
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
 
 	exists, err := resourceArmStorageQueueExists(d, meta)
 	if err != nil {
@@ -106,14 +126,22 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("storage_account_name", id.StorageAccountName)
+
 	return nil
 }
 
 func resourceArmStorageQueueExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -125,7 +153,7 @@ func resourceArmStorageQueueExists(d *schema.ResourceData, meta interface{}) (bo
 		return false, nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	log.Printf("[INFO] Checking for existence of storage queue %q.", name)
 	queueReference := queueClient.GetQueueReference(name)
@@ -145,8 +173,12 @@ func resourceArmStorageQueueExists(d *schema.ResourceData, meta interface{}) (bo
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -157,7 +189,7 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	log.Printf("[INFO] Deleting storage queue %q", name)
 	queueReference := queueClient.GetQueueReference(name)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -15,6 +16,15 @@ func resourceArmStorageShare() *schema.Resource {
 		Read:   resourceArmStorageShareRead,
 		Exists: resourceArmStorageShareExists,
 		Delete: resourceArmStorageShareDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageShareImportState,
+		},
+		MigrateState:  resourceAzureRMStorageShareMigrateState,
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,15 +88,25 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	reference.SetProperties(options)
 
-	d.SetId(name)
+	id := StorageShareID{
+		SubscriptionID:     armClient.subscriptionId,
+		ResourceGroup:      resourceGroupName,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+	d.SetId(id.String())
 	return resourceArmStorageShareRead(d, meta)
 }
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageShareID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -108,7 +128,11 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroupName)
+	d.Set("storage_account_name", storageAccountName)
 
 	reference := fileClient.GetShareReference(name)
 	url := reference.URL()
@@ -120,11 +144,41 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceArmStorageShareImportState imports the Share, along with its quota.
+func resourceArmStorageShareImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	armClient := meta.(*ArmClient)
+
+	id, err := parseStorageShareID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Storage Account %q Not Found", id.StorageAccountName)
+	}
+
+	reference := fileClient.GetShareReference(id.Name)
+	if err := reference.FetchAttributes(&storage.FileRequestOptions{}); err != nil {
+		return nil, fmt.Errorf("Error retrieving the properties of share %q: %s", id.Name, err)
+	}
+	d.Set("quota", reference.Properties.Quota)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceArmStorageShareExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageShareID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -136,7 +190,7 @@ func resourceArmStorageShareExists(d *schema.ResourceData, meta interface{}) (bo
 		return false, nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	log.Printf("[INFO] Checking for existence of share %q.", name)
 	reference := fileClient.GetShareReference(name)
@@ -156,8 +210,12 @@ func resourceArmStorageShareExists(d *schema.ResourceData, meta interface{}) (bo
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageShareID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -168,7 +226,7 @@ func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 
 	reference := fileClient.GetShareReference(name)
 	options := &storage.FileRequestOptions{}
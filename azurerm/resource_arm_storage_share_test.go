@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -15,8 +14,8 @@ import (
 func TestAccAzureRMStorageShare_basic(t *testing.T) {
 	var sS storage.Share
 
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := acctRandInt(t)
+	rs := strings.ToLower(acctRandString(t, 11))
 	config := testAccAzureRMStorageShare_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -37,8 +36,8 @@ func TestAccAzureRMStorageShare_basic(t *testing.T) {
 func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 	var sS storage.Share
 
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := acctRandInt(t)
+	rs := strings.ToLower(acctRandString(t, 11))
 	config := testAccAzureRMStorageShare_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,6 +15,15 @@ func resourceArmStorageTable() *schema.Resource {
 		Create: resourceArmStorageTableCreate,
 		Read:   resourceArmStorageTableRead,
 		Delete: resourceArmStorageTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		MigrateState:  resourceAzureRMStorageTableMigrateState,
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,7 +88,13 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating table %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
-	d.SetId(name)
+	id := StorageTableID{
+		SubscriptionID:     armClient.subscriptionId,
+		ResourceGroup:      resourceGroupName,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+	d.SetId(id.String())
 
 	return resourceArmStorageTableRead(d, meta)
 }
@@ -86,8 +102,12 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageTableID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -99,7 +119,7 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 	metaDataLevel := storage.MinimalMetadata
 	options := &storage.QueryTablesOptions{}
 	tables, err := tableClient.QueryTables(metaDataLevel, options)
@@ -113,6 +133,8 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 		if tableName == name {
 			found = true
 			d.Set("name", tableName)
+			d.Set("resource_group_name", resourceGroupName)
+			d.Set("storage_account_name", storageAccountName)
 		}
 	}
 
@@ -127,8 +149,12 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	storageAccountName := d.Get("storage_account_name").(string)
+	id, err := parseStorageTableID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroupName := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
 
 	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -139,7 +165,7 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	name := d.Get("name").(string)
+	name := id.Name
 	table := tableClient.GetTableReference(name)
 	timeout := uint(60)
 	options := &storage.TableOptions{}
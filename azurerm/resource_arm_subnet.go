@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +22,12 @@ func resourceArmSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -69,7 +77,10 @@ func resourceArmSubnet() *schema.Resource {
 
 func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	subnetClient := client.subnetClient
+	subnetClient := client.subnetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -120,7 +131,7 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		SubnetPropertiesFormat: &properties,
 	}
 
-	_, error := subnetClient.CreateOrUpdate(resGroup, vnetName, name, subnet, make(chan struct{}))
+	_, error := subnetClient.CreateOrUpdate(resGroup, vnetName, name, subnet, ctx.Done())
 	err := <-error
 	if err != nil {
 		return err
@@ -140,15 +151,15 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	subnetClient := meta.(*ArmClient).subnetClient
+	subnetClient := meta.(*ArmClient).subnetClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := subnetClient.Get(resGroup, vnetName, name, "")
 
@@ -194,15 +205,18 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	subnetClient := meta.(*ArmClient).subnetClient
+	subnetClient := meta.(*ArmClient).subnetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name := id.Name
+	vnetName := id.VirtualNetworkName
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
@@ -232,7 +246,7 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	azureRMLockByName(name, subnetResourceName)
 	defer azureRMUnlockByName(name, subnetResourceName)
 
-	_, error := subnetClient.Delete(resGroup, vnetName, name, make(chan struct{}))
+	_, error := subnetClient.Delete(resGroup, vnetName, name, ctx.Done())
 	err = <-error
 
 	return err
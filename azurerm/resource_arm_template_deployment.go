@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -19,6 +20,14 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Read:   resourceArmTemplateDeploymentRead,
 		Update: resourceArmTemplateDeploymentCreate,
 		Delete: resourceArmTemplateDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmTemplateDeploymentImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,7 +69,10 @@ func resourceArmTemplateDeployment() *schema.Resource {
 
 func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
+	deployClient := client.deploymentsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -99,10 +111,27 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 		Properties: &properties,
 	}
 
-	_, error := deployClient.CreateOrUpdate(resGroup, name, deployment, make(chan struct{}))
-	err := <-error
+	// wait for any operation started by a previous (interrupted) run to complete, since this request would otherwise Conflict
+	pendingReq, err := deployClient.GetPreparer(resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+		return fmt.Errorf("Error preparing the request for Template Deployment %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if err := waitForPendingOperation(ctx, deployClient.Client, pendingReq.URL.String()); err != nil {
+		return fmt.Errorf("Error waiting for the pending operation on Template Deployment %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	operation := &pendingOperation{}
+	operation.track(&deployClient.Client)
+
+	_, error := deployClient.CreateOrUpdate(resGroup, name, deployment, ctx.Done())
+	err = <-error
+	if err != nil {
+		id := TemplateDeploymentID{
+			SubscriptionID: client.subscriptionId,
+			ResourceGroup:  resGroup,
+			Name:           name,
+		}
+		return operation.persistIfAccepted(client.StopContext, d, id.String(), fmt.Errorf("Error creating deployment: %+v", err))
 	}
 
 	read, err := deployClient.Get(resGroup, name)
@@ -120,7 +149,7 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 		Pending: []string{"creating", "updating", "accepted", "running"},
 		Target:  []string{"succeeded"},
 		Refresh: templateDeploymentStateRefreshFunc(client, resGroup, name),
-		Timeout: 40 * time.Minute,
+		Timeout: remainingTimeout(ctx, createOrUpdateTimeout(d)),
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Template Deployment (%s) to become available: %+v", name, err)
@@ -131,17 +160,14 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
+	deployClient := client.deploymentsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	resp, err := deployClient.Get(resGroup, name)
 	if err != nil {
@@ -152,6 +178,10 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %s: %+v", name, err)
 	}
 
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("deployment_mode", string(resp.Properties.Mode))
+
 	var outputs map[string]string
 	if resp.Properties.Outputs != nil && len(*resp.Properties.Outputs) > 0 {
 		outputs = make(map[string]string)
@@ -194,24 +224,78 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
+	deployClient := client.deploymentsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
-	_, error := deployClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := deployClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
 }
 
+// resourceArmTemplateDeploymentImportState imports the Template Deployment, along with the template and the
+// values of the parameters it was deployed with. Secure parameters aren't returned by the API, and so need
+// to be specified in the configuration.
+func resourceArmTemplateDeploymentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	deployClient := meta.(*ArmClient).deploymentsClient()
+
+	id, err := parseTemplateDeploymentID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := deployClient.Get(id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Template Deployment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	template, err := deployClient.ExportTemplate(id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting the template of Template Deployment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if template.Template != nil {
+		templateBody, err := json.Marshal(*template.Template)
+		if err != nil {
+			return nil, fmt.Errorf("Error serializing the template of Template Deployment %q: %+v", id.Name, err)
+		}
+		d.Set("template_body", string(templateBody))
+	}
+
+	if resp.Properties != nil && resp.Properties.Parameters != nil {
+		parameters := make(map[string]interface{})
+		for key, parameter := range *resp.Properties.Parameters {
+			parameterMap, ok := parameter.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			value, ok := parameterMap["value"]
+			if !ok {
+				continue
+			}
+
+			switch v := value.(type) {
+			case string, bool, float64:
+				parameters[key] = fmt.Sprint(v)
+			default:
+				log.Printf("[WARN] Ignoring parameter %s: only parameters of type string, bool and int can be imported", key)
+			}
+		}
+		d.Set("parameters", parameters)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandTemplateBody(template string) (map[string]interface{}, error) {
 	var templateBody map[string]interface{}
 	err := json.Unmarshal([]byte(template), &templateBody)
@@ -236,7 +320,7 @@ func normalizeJson(jsonString interface{}) string {
 
 func templateDeploymentStateRefreshFunc(client *ArmClient, resourceGroupName string, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.deploymentsClient.Get(resourceGroupName, name)
+		res, err := client.deploymentsClient().Get(resourceGroupName, name)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in templateDeploymentStateRefreshFunc to Azure ARM for Template Deployment '%s' (RG: '%s'): %+v", name, resourceGroupName, err)
 		}
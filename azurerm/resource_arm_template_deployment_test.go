@@ -6,13 +6,12 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTemplateDeployment_basicMultiple(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -30,7 +29,7 @@ func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
 }
 
 func TestAccAzureRMTemplateDeployment_disappears(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTemplateDeployment_basicSingle(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -50,7 +49,7 @@ func TestAccAzureRMTemplateDeployment_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMTemplateDeployment_withParams(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTemplateDeployment_withParams(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -69,7 +68,7 @@ func TestAccAzureRMTemplateDeployment_withParams(t *testing.T) {
 }
 
 func TestAccAzureRMTemplateDeployment_withOutputs(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTemplateDeployment_withOutputs(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -92,7 +91,7 @@ func TestAccAzureRMTemplateDeployment_withOutputs(t *testing.T) {
 }
 
 func TestAccAzureRMTemplateDeployment_withError(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTemplateDeployment_withError(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -121,7 +120,7 @@ func testCheckAzureRMTemplateDeploymentExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		conn := testAccProvider.Meta().(*ArmClient).deploymentsClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -150,7 +149,7 @@ func testCheckAzureRMTemplateDeploymentDisappears(name string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		conn := testAccProvider.Meta().(*ArmClient).deploymentsClient()
 
 		_, error := conn.Delete(resourceGroup, name, make(chan struct{}))
 		err := <-error
@@ -163,7 +162,7 @@ func testCheckAzureRMTemplateDeploymentDisappears(name string) resource.TestChec
 }
 
 func testCheckAzureRMTemplateDeploymentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).vmClient
+	conn := testAccProvider.Meta().(*ArmClient).vmClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_template_deployment" {
@@ -3,7 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +20,12 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -101,7 +107,7 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 }
 
 func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManagerEndpointsClient()
 
 	log.Printf("[INFO] preparing arguments for ARM TrafficManager Endpoint creation.")
 
@@ -136,26 +142,16 @@ func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManagerEndpointsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTrafficManagerEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-
-	// lookup endpointType in Azure ID path
-	var endpointType string
-	typeRegex := regexp.MustCompile("azureEndpoints|externalEndpoints|nestedEndpoints")
-	for k := range id.Path {
-		if typeRegex.MatchString(k) {
-			endpointType = k
-		}
-	}
-	profileName := id.Path["trafficManagerProfiles"]
-
-	// endpoint name is keyed by endpoint type in ARM ID
-	name := id.Path[endpointType]
+	endpointType := id.Type
+	profileName := id.ProfileName
+	name := id.Name
 
 	resp, err := client.Get(resGroup, profileName, endpointType, name)
 	if err != nil {
@@ -185,18 +181,16 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManagerEndpointsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTrafficManagerEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	endpointType := d.Get("type").(string)
-	profileName := id.Path["trafficManagerProfiles"]
-
-	// endpoint name is keyed by endpoint type in ARM ID
-	name := id.Path[endpointType]
+	endpointType := id.Type
+	profileName := id.ProfileName
+	name := id.Name
 
 	_, err = client.Delete(resGroup, profileName, endpointType, name)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -22,6 +23,12 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -116,7 +123,7 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 }
 
 func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerProfilesClient()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
 
@@ -124,7 +131,10 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 	// must be provided in request
 	location := "global"
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	profile := trafficmanager.Profile{
 		Name:              &name,
@@ -133,7 +143,7 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 		Tags:              expandTags(tags),
 	}
 
-	_, err := client.CreateOrUpdate(resGroup, name, profile)
+	_, err = client.CreateOrUpdate(resGroup, name, profile)
 	if err != nil {
 		return err
 	}
@@ -152,14 +162,14 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerProfilesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTrafficManagerProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name := id.Name
 
 	resp, err := client.Get(resGroup, name)
 	if err != nil {
@@ -187,20 +197,20 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	monitorFlat := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
 	d.Set("monitor_config", schema.NewSet(resourceAzureRMTrafficManagerMonitorConfigHash, monitorFlat))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerProfilesClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseTrafficManagerProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name := id.Name
 
 	_, err = client.Delete(resGroup, name)
 
@@ -6,14 +6,47 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_traffic_manager_profile", &resource.Sweeper{
+		Name: "azurerm_traffic_manager_profile",
+		F:    testSweepTrafficManagerProfiles,
+	})
+}
+
+func testSweepTrafficManagerProfiles(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.trafficManagerProfilesClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Traffic Manager Profiles..")
+	results, err := client.ListAll()
+	if err != nil {
+		return fmt.Errorf("Error listing Traffic Manager Profiles: %+v", err)
+	}
+
+	if results.Value != nil {
+		for _, profile := range *results.Value {
+			resources = append(resources, sweepableResource{profile.ID, profile.Name, profile.Location})
+		}
+	}
+
+	return sweepAcceptanceTestResources(region, "Traffic Manager Profile", resources, func(resourceGroup string, name string) error {
+		_, err := client.Delete(resourceGroup, name)
+		return err
+	})
+}
+
 func TestAccAzureRMTrafficManagerProfile_weighted(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_weighted(ri, testLocation())
 
 	fqdn := fmt.Sprintf("acctesttmp%d.trafficmanager.net", ri)
@@ -37,7 +70,7 @@ func TestAccAzureRMTrafficManagerProfile_weighted(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_performance(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_performance(ri, testLocation())
 
 	fqdn := fmt.Sprintf("acctesttmp%d.trafficmanager.net", ri)
@@ -61,7 +94,7 @@ func TestAccAzureRMTrafficManagerProfile_performance(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_priority(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_priority(ri, testLocation())
 	fqdn := fmt.Sprintf("acctesttmp%d.trafficmanager.net", ri)
 
@@ -84,7 +117,7 @@ func TestAccAzureRMTrafficManagerProfile_priority(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_withTags(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMTrafficManagerProfile_withTags(ri, testLocation())
 	postConfig := testAccAzureRMTrafficManagerProfile_withTagsUpdated(ri, testLocation())
 
@@ -129,7 +162,7 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient()
 
 		resp, err := conn.Get(resourceGroup, name)
 		if err != nil {
@@ -145,7 +178,7 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 }
 
 func testCheckAzureRMTrafficManagerProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_traffic_manager_profile" {
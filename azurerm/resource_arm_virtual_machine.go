@@ -2,29 +2,40 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	riviera "github.com/jen20/riviera/azure"
 )
 
+var virtualMachineResourceName = "azurerm_virtual_machine"
+
 func resourceArmVirtualMachine() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualMachineCreate,
 		Read:   resourceArmVirtualMachineRead,
-		Update: resourceArmVirtualMachineCreate,
+		Update: resourceArmVirtualMachineUpdate,
 		Delete: resourceArmVirtualMachineDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -91,6 +102,18 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Required: true,
 			},
 
+			"power_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					virtualMachinePowerStateRunning,
+					virtualMachinePowerStateStopped,
+					virtualMachinePowerStateDeallocated,
+				}, true),
+			},
+
 			"storage_image_reference": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -495,21 +518,62 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Optional: true,
 			},
 
+			"delete_network_interfaces_on_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.SystemAssigned),
+							}, true),
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
+
+	return resourceArmVirtualMachineCreateOrUpdate(ctx, d, meta)
+}
+
+// resourceArmVirtualMachineCreateOrUpdate creates or updates the Virtual Machine within the context's deadline,
+// so that resizing a Virtual Machine which has to be deallocated first doesn't extend the Update Timeout.
+func resourceArmVirtualMachineCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	vmClient := client.vmClient
+	vmClient := client.vmClient()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	expandedTags := expandTags(tags)
 
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
@@ -591,10 +655,29 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 		vm.Plan = plan
 	}
 
-	_, vmError := vmClient.CreateOrUpdate(resGroup, name, vm, make(chan struct{}))
+	vm.Identity = expandAzureRmVirtualMachineIdentity(d)
+
+	// wait for any operation started by a previous (interrupted) run to complete, since this request would otherwise Conflict
+	pendingReq, err := vmClient.GetPreparer(resGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error preparing the request for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if err := waitForPendingOperation(ctx, vmClient.Client, pendingReq.URL.String()); err != nil {
+		return fmt.Errorf("Error waiting for the pending operation on Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	operation := &pendingOperation{}
+	operation.track(&vmClient.Client)
+
+	_, vmError := vmClient.CreateOrUpdate(resGroup, name, vm, ctx.Done())
 	vmErr := <-vmError
 	if vmErr != nil {
-		return vmErr
+		id := VirtualMachineID{
+			SubscriptionID: client.subscriptionId,
+			ResourceGroup:  resGroup,
+			Name:           name,
+		}
+		return operation.persistIfAccepted(client.StopContext, d, id.String(), vmErr)
 	}
 
 	read, err := vmClient.Get(resGroup, name, "")
@@ -607,20 +690,221 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*read.ID)
 
+	if v, ok := d.GetOk("power_state"); ok {
+		if err := setVirtualMachinePowerState(ctx, vmClient, resGroup, name, v.(string), remainingTimeout(ctx, createOrUpdateTimeout(d))); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmVirtualMachineRead(d, meta)
+}
+
+func resourceArmVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("vm_size") {
+		return resourceArmVirtualMachineCreate(d, meta)
+	}
+
+	vmClient := meta.(*ArmClient).vmClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	id, err := parseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Name
+	vmSize := d.Get("vm_size").(string)
+
+	// the VM can only be resized in-place when the new size is available on the hardware cluster it's
+	// running on - otherwise it needs to be deallocated so that it can be moved to another cluster
+	available, err := virtualMachineSizeIsAvailable(vmClient, resGroup, name, vmSize)
+	if err != nil {
+		return err
+	}
+	if available {
+		log.Printf("[INFO] Resizing Virtual Machine %q (Resource Group %q) to %q in-place", name, resGroup, vmSize)
+		if err := resourceArmVirtualMachineCreateOrUpdate(ctx, d, meta); err != nil {
+			return fmt.Errorf("Error resizing Virtual Machine %q (Resource Group %q) to %q in-place: %+v", name, resGroup, vmSize, err)
+		}
+
+		return nil
+	}
+
+	// the power state is retrieved before the VM is deallocated, since it's refreshed after it's resized
+	powerState := d.Get("power_state").(string)
+	if powerState == "" {
+		powerState = virtualMachinePowerStateRunning
+	}
+
+	log.Printf("[INFO] Size %q isn't available on the hardware cluster hosting Virtual Machine %q (Resource Group %q) - deallocating it to resize", vmSize, name, resGroup)
+	_, deallocateError := vmClient.Deallocate(resGroup, name, ctx.Done())
+	if err := <-deallocateError; err != nil {
+		return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q) to resize it: %+v", name, resGroup, err)
+	}
+
+	log.Printf("[INFO] Resizing Virtual Machine %q (Resource Group %q) to %q", name, resGroup, vmSize)
+	updateErr := resourceArmVirtualMachineCreateOrUpdate(ctx, d, meta)
+	if updateErr != nil {
+		updateErr = fmt.Errorf("Error resizing Virtual Machine %q (Resource Group %q) to %q after deallocating it: %+v", name, resGroup, vmSize, updateErr)
+	}
+
+	// the VM is returned to its power state regardless of whether the resize succeeded, so that it's not
+	// left deallocated - unless that's the power state it should be in
+	log.Printf("[INFO] Returning Virtual Machine %q (Resource Group %q) to the %q power state after resizing it", name, resGroup, powerState)
+	if err := setVirtualMachinePowerState(ctx, vmClient, resGroup, name, powerState, remainingTimeout(ctx, d.Timeout(schema.TimeoutUpdate))); err != nil {
+		err = fmt.Errorf("Error returning Virtual Machine %q (Resource Group %q) to the %q power state after deallocating it to resize it: %+v", name, resGroup, powerState, err)
+		if updateErr != nil {
+			return fmt.Errorf("%+v\n\nIn addition: %+v", updateErr, err)
+		}
+		return err
+	}
+
+	if updateErr != nil {
+		return updateErr
+	}
+
 	return resourceArmVirtualMachineRead(d, meta)
 }
 
+// virtualMachineSizeIsAvailable returns whether the Virtual Machine can be resized to the specified size
+// without being deallocated.
+func virtualMachineSizeIsAvailable(vmClient compute.VirtualMachinesClient, resGroup string, name string, vmSize string) (bool, error) {
+	sizes, err := vmClient.ListAvailableSizes(resGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error listing the sizes available for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if sizes.Value != nil {
+		for _, size := range *sizes.Value {
+			if size.Name != nil && strings.EqualFold(*size.Name, vmSize) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+const (
+	virtualMachinePowerStateRunning     = "running"
+	virtualMachinePowerStateStopped     = "stopped"
+	virtualMachinePowerStateDeallocated = "deallocated"
+)
+
+// setVirtualMachinePowerState starts, stops or deallocates the Virtual Machine if it's not already in the
+// specified power state, waiting for the transition to complete.
+func setVirtualMachinePowerState(ctx context.Context, vmClient compute.VirtualMachinesClient, resGroup string, name string, powerState string, timeout time.Duration) error {
+	powerState = strings.ToLower(powerState)
+
+	current, err := virtualMachinePowerState(vmClient, resGroup, name)
+	if err != nil {
+		return err
+	}
+	if current == powerState {
+		return nil
+	}
+
+	// a deallocated VM has to be started before it can be stopped, since stopping it retains the hardware
+	if powerState == virtualMachinePowerStateStopped && current == virtualMachinePowerStateDeallocated {
+		if err := setVirtualMachinePowerState(ctx, vmClient, resGroup, name, virtualMachinePowerStateRunning, timeout); err != nil {
+			return err
+		}
+	}
+
+	var errChan <-chan error
+	switch powerState {
+	case virtualMachinePowerStateRunning:
+		log.Printf("[INFO] Starting Virtual Machine %q (Resource Group %q)", name, resGroup)
+		_, errChan = vmClient.Start(resGroup, name, ctx.Done())
+	case virtualMachinePowerStateStopped:
+		log.Printf("[INFO] Stopping Virtual Machine %q (Resource Group %q)", name, resGroup)
+		_, errChan = vmClient.PowerOff(resGroup, name, ctx.Done())
+	case virtualMachinePowerStateDeallocated:
+		log.Printf("[INFO] Deallocating Virtual Machine %q (Resource Group %q)", name, resGroup)
+		_, errChan = vmClient.Deallocate(resGroup, name, ctx.Done())
+	default:
+		return fmt.Errorf("Unsupported power state %q for Virtual Machine %q (Resource Group %q)", powerState, name, resGroup)
+	}
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error changing the power state of Virtual Machine %q (Resource Group %q) to %q: %+v", name, resGroup, powerState, err)
+	}
+
+	pending := []string{"starting", "stopping", "deallocating"}
+	for _, state := range []string{virtualMachinePowerStateRunning, virtualMachinePowerStateStopped, virtualMachinePowerStateDeallocated} {
+		if state != powerState {
+			pending = append(pending, state)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for Virtual Machine %q (Resource Group %q) to be %q", name, resGroup, powerState)
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{powerState},
+		Refresh:    virtualMachinePowerStateRefreshFunc(vmClient, resGroup, name),
+		Timeout:    remainingTimeout(ctx, timeout),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be %q: %+v", name, resGroup, powerState, err)
+	}
+
+	return nil
+}
+
+func virtualMachinePowerStateRefreshFunc(vmClient compute.VirtualMachinesClient, resGroup string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		powerState, err := virtualMachinePowerState(vmClient, resGroup, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return powerState, powerState, nil
+	}
+}
+
+// virtualMachinePowerState returns the current power state of the Virtual Machine from its instance view.
+func virtualMachinePowerState(vmClient compute.VirtualMachinesClient, resGroup string, name string) (string, error) {
+	resp, err := vmClient.Get(resGroup, name, compute.InstanceView)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the instance view of Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if resp.VirtualMachineProperties == nil {
+		return "", nil
+	}
+
+	return flattenAzureRmVirtualMachinePowerState(resp.VirtualMachineProperties.InstanceView), nil
+}
+
+// flattenAzureRmVirtualMachinePowerState returns the power state (e.g. `running`) from the `PowerState/running`
+// status in the instance view - which is empty while the VM is being provisioned.
+func flattenAzureRmVirtualMachinePowerState(instanceView *compute.VirtualMachineInstanceView) string {
+	if instanceView == nil || instanceView.Statuses == nil {
+		return ""
+	}
+
+	for _, status := range *instanceView.Statuses {
+		if status.Code != nil && strings.HasPrefix(strings.ToLower(*status.Code), "powerstate/") {
+			return strings.TrimPrefix(strings.ToLower(*status.Code), "powerstate/")
+		}
+	}
+
+	return ""
+}
+
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	vmClient := meta.(*ArmClient).vmClient
+	vmClient := meta.(*ArmClient).vmClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
-	resp, err := vmClient.Get(resGroup, name, "")
+	resp, err := vmClient.Get(resGroup, name, compute.InstanceView)
 
 	if err != nil {
 		if responseWasNotFound(resp.Response) {
@@ -640,11 +924,16 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if err := d.Set("identity", flattenAzureRmVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting Virtual Machine Identity: %#v", err)
+	}
+
 	if resp.VirtualMachineProperties.AvailabilitySet != nil {
 		d.Set("availability_set_id", strings.ToLower(*resp.VirtualMachineProperties.AvailabilitySet.ID))
 	}
 
 	d.Set("vm_size", resp.VirtualMachineProperties.HardwareProfile.VMSize)
+	d.Set("power_state", flattenAzureRmVirtualMachinePowerState(resp.VirtualMachineProperties.InstanceView))
 
 	if resp.VirtualMachineProperties.StorageProfile.ImageReference != nil {
 		if err := d.Set("storage_image_reference", schema.NewSet(resourceArmVirtualMachineStorageImageReferenceHash, flattenAzureRmVirtualMachineImageReference(resp.VirtualMachineProperties.StorageProfile.ImageReference))); err != nil {
@@ -709,22 +998,25 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	vmClient := meta.(*ArmClient).vmClient
+	vmClient := meta.(*ArmClient).vmClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
-	_, error := vmClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := vmClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	if err != nil {
@@ -745,7 +1037,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 				return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 			}
 		} else if osDisk.ManagedDisk != nil {
-			if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
+			if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *osDisk.ManagedDisk.ID, meta); err != nil {
 				return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 			}
 		} else {
@@ -768,7 +1060,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
 			} else if disk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
 			} else {
@@ -777,6 +1069,17 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// delete Network Interfaces (and their Public IPs) if opted in
+	if deleteNetworkInterfaces := d.Get("delete_network_interfaces_on_termination").(bool); deleteNetworkInterfaces {
+		log.Printf("[INFO] delete_network_interfaces_on_termination is enabled, deleting each network interface from %s", name)
+
+		for _, networkInterfaceID := range d.Get("network_interface_ids").(*schema.Set).List() {
+			if err = resourceArmVirtualMachineDeleteNetworkInterface(ctx, networkInterfaceID.(string), meta); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -819,17 +1122,17 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta interface{}) error {
-	diskClient := meta.(*ArmClient).diskClient
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, managedDiskID string, meta interface{}) error {
+	diskClient := meta.(*ArmClient).diskClient()
 
-	id, err := parseAzureResourceID(managedDiskID)
+	id, err := parseManagedDiskID(managedDiskID)
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
-	_, error := diskClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := diskClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 	if err != nil {
 		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
@@ -838,6 +1141,97 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 	return nil
 }
 
+// resourceArmVirtualMachineDeleteNetworkInterface deletes the Network Interface, followed by the Public IPs
+// associated with its IP Configurations - which can't be deleted while they're in use.
+func resourceArmVirtualMachineDeleteNetworkInterface(ctx context.Context, networkInterfaceID string, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient()
+	publicIPClient := meta.(*ArmClient).publicIPClient()
+
+	id, err := parseNetworkInterfaceID(networkInterfaceID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Name
+
+	iface, err := ifaceClient.Get(resGroup, name, "")
+	if err != nil {
+		if responseWasNotFound(iface.Response) {
+			log.Printf("[INFO] Network Interface %q (Resource Group %q) was not found so won't be deleted", name, resGroup)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	publicIPAddressIDs := make([]string, 0)
+	subnetNamesToLock := make([]string, 0)
+	virtualNetworkNamesToLock := make([]string, 0)
+	if props := iface.InterfacePropertiesFormat; props != nil {
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupName, err := parseNetworkSecurityGroupName(*props.NetworkSecurityGroup.ID)
+			if err != nil {
+				return err
+			}
+
+			azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+			defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		}
+
+		if props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if config.InterfaceIPConfigurationPropertiesFormat == nil {
+					continue
+				}
+
+				if subnet := config.InterfaceIPConfigurationPropertiesFormat.Subnet; subnet != nil && subnet.ID != nil {
+					subnetId, err := parseSubnetID(*subnet.ID)
+					if err != nil {
+						return err
+					}
+					// each name's only locked once, since IP Configurations commonly share a Subnet
+					if !sliceContainsValue(subnetNamesToLock, subnetId.Name) {
+						subnetNamesToLock = append(subnetNamesToLock, subnetId.Name)
+					}
+					if !sliceContainsValue(virtualNetworkNamesToLock, subnetId.VirtualNetworkName) {
+						virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, subnetId.VirtualNetworkName)
+					}
+				}
+
+				if publicIP := config.InterfaceIPConfigurationPropertiesFormat.PublicIPAddress; publicIP != nil && publicIP.ID != nil {
+					publicIPAddressIDs = append(publicIPAddressIDs, *publicIP.ID)
+				}
+			}
+		}
+	}
+
+	azureRMLockMultipleByName(&subnetNamesToLock, subnetResourceName)
+	defer azureRMUnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
+
+	azureRMLockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	defer azureRMUnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+
+	log.Printf("[INFO] Deleting Network Interface %q (Resource Group %q)", name, resGroup)
+	_, error := ifaceClient.Delete(resGroup, name, ctx.Done())
+	if err := <-error; err != nil {
+		return fmt.Errorf("Error deleting Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	for _, publicIPAddressID := range publicIPAddressIDs {
+		publicIPId, err := parsePublicIPAddressID(publicIPAddressID)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Deleting Public IP %q (Resource Group %q) from Network Interface %q", publicIPId.Name, publicIPId.ResourceGroup, name)
+		_, error := publicIPClient.Delete(publicIPId.ResourceGroup, publicIPId.Name, ctx.Done())
+		if err := <-error; err != nil {
+			return fmt.Errorf("Error deleting Public IP %q (Resource Group %q): %+v", publicIPId.Name, publicIPId.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmVirtualMachinePlanHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -917,6 +1311,20 @@ func flattenAzureRmVirtualMachinePlan(plan *compute.Plan) []interface{} {
 	return []interface{}{result}
 }
 
+func flattenAzureRmVirtualMachineIdentity(identity *compute.VirtualMachineIdentity) []interface{} {
+	if identity == nil || strings.EqualFold(string(identity.Type), "None") {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+	if identity.PrincipalID != nil {
+		result["principal_id"] = *identity.PrincipalID
+	}
+
+	return []interface{}{result}
+}
+
 func flattenAzureRmVirtualMachineImageReference(image *compute.ImageReference) []interface{} {
 	result := make(map[string]interface{})
 	if image.Publisher != nil {
@@ -1127,6 +1535,25 @@ func expandAzureRmVirtualMachinePlan(d *schema.ResourceData) (*compute.Plan, err
 	}, nil
 }
 
+func expandAzureRmVirtualMachineIdentity(d *schema.ResourceData) *compute.VirtualMachineIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
+		// the identity is only removed when it's explicitly disabled, rather than omitted from the PUT
+		if !d.IsNewResource() && d.HasChange("identity") {
+			return &compute.VirtualMachineIdentity{
+				Type: compute.ResourceIdentityType("None"),
+			}
+		}
+
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	return &compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(identity["type"].(string)),
+	}
+}
+
 func expandAzureRmVirtualMachineOsProfile(d *schema.ResourceData) (*compute.OSProfile, error) {
 	osProfiles := d.Get("os_profile").(*schema.Set).List()
 
@@ -1391,8 +1818,8 @@ func expandAzureRmVirtualMachineDiagnosticsProfile(d *schema.ResourceData) *comp
 		bootDiagnostic := bootDiagnostics[0].(map[string]interface{})
 
 		diagnostic := &compute.BootDiagnostics{
-			Enabled:    riviera.Bool(bootDiagnostic["enabled"].(bool)),
-			StorageURI: riviera.String(bootDiagnostic["storage_uri"].(string)),
+			Enabled:    to.BoolPtr(bootDiagnostic["enabled"].(bool)),
+			StorageURI: to.StringPtr(bootDiagnostic["storage_uri"].(string)),
 		}
 
 		diagnosticsProfile.BootDiagnostics = diagnostic
@@ -1417,7 +1844,7 @@ func expandAzureRmVirtualMachineImageReference(d *schema.ResourceData) (*compute
 	}
 
 	if imageID != "" {
-		imageReference.ID = riviera.String(storageImageRef["id"].(string))
+		imageReference.ID = to.StringPtr(storageImageRef["id"].(string))
 	} else {
 		offer := storageImageRef["offer"].(string)
 		sku := storageImageRef["sku"].(string)
@@ -1535,7 +1962,7 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 }
 
 func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
-	client := meta.(*ArmClient).resourceFindClient
+	client := meta.(*ArmClient).resourceFindClient()
 	filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Storage/storageAccounts'", storageAccountName)
 	expand := ""
 	var pager *int32
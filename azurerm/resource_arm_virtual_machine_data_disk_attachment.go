@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Read:   resourceArmVirtualMachineDataDiskAttachmentRead,
+		Update: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Delete: resourceArmVirtualMachineDataDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"managed_disk_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"lun": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 63),
+			},
+
+			"caching": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.None),
+					string(compute.ReadOnly),
+					string(compute.ReadWrite),
+				}, true),
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
+
+	vmId, err := parseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+	diskId, err := parseManagedDiskID(d.Get("managed_disk_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := vmId.ResourceGroup
+	vmName := vmId.Name
+	name := diskId.Name
+
+	// the Data Disks are updated by PUT'ing the entire Virtual Machine, so concurrent changes would overwrite each other
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
+	vm, err := client.Get(resGroup, vmName, "")
+	if err != nil {
+		if responseWasNotFound(vm.Response) {
+			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", vmName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	lun := int32(d.Get("lun").(int))
+	caching := d.Get("caching").(string)
+	managedDiskId := d.Get("managed_disk_id").(string)
+
+	disks := make([]compute.DataDisk, 0)
+	if vm.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+		disks = *vm.VirtualMachineProperties.StorageProfile.DataDisks
+	}
+
+	index := findVirtualMachineDataDiskByName(disks, name)
+	if d.IsNewResource() {
+		if index != -1 {
+			return fmt.Errorf("Managed Disk %q is already attached to Virtual Machine %q (Resource Group %q)", name, vmName, resGroup)
+		}
+		for _, disk := range disks {
+			if disk.Lun != nil && *disk.Lun == lun {
+				return fmt.Errorf("A Data Disk is already attached to Virtual Machine %q (Resource Group %q) at LUN %d", vmName, resGroup, lun)
+			}
+		}
+
+		disks = append(disks, compute.DataDisk{
+			Name:         &name,
+			Lun:          &lun,
+			Caching:      compute.CachingTypes(caching),
+			CreateOption: compute.Attach,
+			ManagedDisk: &compute.ManagedDiskParameters{
+				ID: &managedDiskId,
+			},
+		})
+	} else {
+		if index == -1 {
+			return fmt.Errorf("Managed Disk %q is no longer attached to Virtual Machine %q (Resource Group %q)", name, vmName, resGroup)
+		}
+
+		disks[index].Caching = compute.CachingTypes(caching)
+	}
+
+	vm.VirtualMachineProperties.StorageProfile.DataDisks = &disks
+
+	// the Extensions are returned in the GET but can't be sent in the PUT
+	vm.Resources = nil
+
+	log.Printf("[INFO] Attaching Managed Disk %q to Virtual Machine %q (Resource Group %q) at LUN %d", name, vmName, resGroup, lun)
+	_, vmError := client.CreateOrUpdate(resGroup, vmName, vm, ctx.Done())
+	if err := <-vmError; err != nil {
+		return fmt.Errorf("Error attaching Managed Disk %q to Virtual Machine %q (Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	id := VirtualMachineDataDiskID{
+		SubscriptionID:     vmId.SubscriptionID,
+		ResourceGroup:      resGroup,
+		VirtualMachineName: vmName,
+		Name:               name,
+	}
+	d.SetId(id.String())
+
+	return resourceArmVirtualMachineDataDiskAttachmentRead(d, meta)
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient()
+
+	id, err := parseVirtualMachineDataDiskID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.VirtualMachineName
+	name := id.Name
+
+	vm, err := client.Get(resGroup, vmName, "")
+	if err != nil {
+		if responseWasNotFound(vm.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Data Disk %q from state", vmName, resGroup, name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	disks := make([]compute.DataDisk, 0)
+	if vm.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+		disks = *vm.VirtualMachineProperties.StorageProfile.DataDisks
+	}
+
+	index := findVirtualMachineDataDiskByName(disks, name)
+	if index == -1 {
+		log.Printf("[DEBUG] Data Disk %q was not found on Virtual Machine %q (Resource Group %q) - removing from state", name, vmName, resGroup)
+		d.SetId("")
+		return nil
+	}
+	disk := disks[index]
+
+	vmId := VirtualMachineID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  resGroup,
+		Name:           vmName,
+	}
+	d.Set("virtual_machine_id", vmId.String())
+	d.Set("caching", string(disk.Caching))
+	if disk.Lun != nil {
+		d.Set("lun", int(*disk.Lun))
+	}
+	if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+		d.Set("managed_disk_id", *disk.ManagedDisk.ID)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	id, err := parseVirtualMachineDataDiskID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.VirtualMachineName
+	name := id.Name
+
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
+	vm, err := client.Get(resGroup, vmName, "")
+	if err != nil {
+		if responseWasNotFound(vm.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	disks := make([]compute.DataDisk, 0)
+	if vm.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+		disks = *vm.VirtualMachineProperties.StorageProfile.DataDisks
+	}
+
+	index := findVirtualMachineDataDiskByName(disks, name)
+	if index == -1 {
+		return nil
+	}
+	disks = append(disks[:index], disks[index+1:]...)
+
+	vm.VirtualMachineProperties.StorageProfile.DataDisks = &disks
+	vm.Resources = nil
+
+	log.Printf("[INFO] Detaching Data Disk %q from Virtual Machine %q (Resource Group %q)", name, vmName, resGroup)
+	_, vmError := client.CreateOrUpdate(resGroup, vmName, vm, ctx.Done())
+	if err := <-vmError; err != nil {
+		return fmt.Errorf("Error detaching Data Disk %q from Virtual Machine %q (Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	return nil
+}
+
+// findVirtualMachineDataDiskByName returns the index of the Data Disk with the specified name, or -1
+// if it's not attached to the Virtual Machine.
+func findVirtualMachineDataDiskByName(disks []compute.DataDisk, name string) int {
+	for i, disk := range disks {
+		if disk.Name != nil && strings.EqualFold(*disk.Name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
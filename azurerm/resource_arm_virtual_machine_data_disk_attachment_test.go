@@ -0,0 +1,423 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctRandInt(t)
+	config := testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, testLocation(), "None")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lun", "0"),
+					resource.TestCheckResourceAttr(resourceName, "caching", "None"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_updatingCaching(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctRandInt(t)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "None"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "caching", "None"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location, "ReadOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "caching", "ReadOnly"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(t *testing.T) {
+	ri := acctRandInt(t)
+	config := testAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists("azurerm_virtual_machine_data_disk_attachment.first"),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists("azurerm_virtual_machine_data_disk_attachment.second"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMVirtualMachineDataDiskAttachment_fakeArm(t *testing.T) {
+	ri := acctRandInt(t)
+	vmId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Compute/virtualMachines/acctvm-%d", fakeArmSubscriptionID, ri, ri)
+	preConfig := testResourceAzureRMVirtualMachineDataDiskAttachment_fakeArm(ri, "None")
+	postConfig := testResourceAzureRMVirtualMachineDataDiskAttachment_fakeArm(ri, "ReadWrite")
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	checkDataDisks := func(expected ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			vm, ok := server.get(vmId)
+			if !ok {
+				return fmt.Errorf("Virtual Machine %q was not found", vmId)
+			}
+
+			actual := make([]string, 0)
+			storageProfile := vm["properties"].(map[string]interface{})["storageProfile"].(map[string]interface{})
+			if dataDisks, ok := storageProfile["dataDisks"].([]interface{}); ok {
+				for _, v := range dataDisks {
+					disk := v.(map[string]interface{})
+					actual = append(actual, fmt.Sprintf("%s@%v/%s", disk["name"], disk["lun"], disk["caching"]))
+				}
+			}
+
+			if strings.Join(expected, ",") != strings.Join(actual, ",") {
+				return fmt.Errorf("Expected the Data Disks %+v to be attached but got %+v", expected, actual)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_data_disk_attachment.first", "lun", "1"),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_data_disk_attachment.second", "lun", "2"),
+					func(s *terraform.State) error {
+						// the attachments are made concurrently (in no particular order), so without the lock
+						// each would overwrite the other
+						vm, _ := server.get(vmId)
+						storageProfile := vm["properties"].(map[string]interface{})["storageProfile"].(map[string]interface{})
+						if disks := storageProfile["dataDisks"].([]interface{}); len(disks) != 2 {
+							return fmt.Errorf("Expected 2 Data Disks to be attached but got %+v", disks)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "azurerm_virtual_machine_data_disk_attachment.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// detaching the second disk leaves the first attached
+				Config: strings.Split(postConfig, "# second")[0],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_data_disk_attachment.first", "caching", "ReadWrite"),
+					checkDataDisks(fmt.Sprintf("acctestd1-%d@1/ReadWrite", ri)),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseVirtualMachineDataDiskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).vmClient()
+		vm, err := conn.Get(id.ResourceGroup, id.VirtualMachineName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vmClient: %+v", err)
+		}
+
+		if vm.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+			if findVirtualMachineDataDiskByName(*vm.VirtualMachineProperties.StorageProfile.DataDisks, id.Name) != -1 {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Data Disk %q is not attached to Virtual Machine %q (Resource Group %q)", id.Name, id.VirtualMachineName, id.ResourceGroup)
+	}
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).vmClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_data_disk_attachment" {
+			continue
+		}
+
+		id, err := parseVirtualMachineDataDiskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		vm, err := conn.Get(id.ResourceGroup, id.VirtualMachineName, "")
+		if err != nil {
+			if responseWasNotFound(vm.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		if vm.VirtualMachineProperties.StorageProfile.DataDisks != nil {
+			if findVirtualMachineDataDiskByName(*vm.VirtualMachineProperties.StorageProfile.DataDisks, id.Name) != -1 {
+				return fmt.Errorf("Data Disk %q is still attached to Virtual Machine %q (Resource Group %q)", id.Name, id.VirtualMachineName, id.ResourceGroup)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D1_v2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "myosdisk1"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  # the Data Disks are managed by the attachments
+  lifecycle {
+    ignore_changes = ["storage_data_disk"]
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_basic(rInt int, location string, caching string) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "%s"
+}
+`, template, rInt, caching)
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "first" {
+  name                 = "acctestd1-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "first" {
+  managed_disk_id    = "${azurerm_managed_disk.first.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "10"
+  caching            = "None"
+}
+
+resource "azurerm_managed_disk" "second" {
+  name                 = "acctestd2-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "second" {
+  managed_disk_id    = "${azurerm_managed_disk.second.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "20"
+  caching            = "ReadOnly"
+}
+`, template, rInt, rInt)
+}
+
+// the fake ARM server doesn't allocate IP Addresses, so the Network Interface is referenced by its ID
+func testResourceAzureRMVirtualMachineDataDiskAttachment_fakeArm(rInt int, caching string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "West US"
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_resource_group.test.id}/providers/Microsoft.Network/networkInterfaces/acctni-%d"]
+  vm_size               = "Standard_D1_v2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name          = "myosdisk1"
+    caching       = "ReadWrite"
+    create_option = "FromImage"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  lifecycle {
+    ignore_changes = ["storage_data_disk"]
+  }
+}
+
+resource "azurerm_managed_disk" "first" {
+  name                 = "acctestd1-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "first" {
+  managed_disk_id    = "${azurerm_managed_disk.first.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "1"
+  caching            = "%s"
+}
+
+# second
+resource "azurerm_managed_disk" "second" {
+  name                 = "acctestd2-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "second" {
+  managed_disk_id    = "${azurerm_managed_disk.second.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "2"
+  caching            = "None"
+}
+`, rInt, rInt, rInt, rInt, rInt, caching, rInt)
+}
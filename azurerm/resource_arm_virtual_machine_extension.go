@@ -1,7 +1,9 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +21,12 @@ func resourceArmVirtualMachineExtensions() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -82,7 +90,10 @@ func resourceArmVirtualMachineExtensions() *schema.Resource {
 }
 
 func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmExtensionClient
+	client := meta.(*ArmClient).vmExtensionClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
@@ -92,7 +103,10 @@ func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta inte
 	extensionType := d.Get("type").(string)
 	typeHandlerVersion := d.Get("type_handler_version").(string)
 	autoUpgradeMinor := d.Get("auto_upgrade_minor_version").(bool)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	extension := compute.VirtualMachineExtension{
 		Location: &location,
@@ -121,8 +135,8 @@ func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta inte
 		extension.VirtualMachineExtensionProperties.ProtectedSettings = &protectedSettings
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, vmName, name, extension, make(chan struct{}))
-	err := <-error
+	_, error := client.CreateOrUpdate(resGroup, vmName, name, extension, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -142,15 +156,15 @@ func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmExtensionClient
+	client := meta.(*ArmClient).vmExtensionClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineExtensionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vmName := id.Path["virtualMachines"]
-	name := id.Path["extensions"]
+	vmName := id.VirtualMachineName
+	name := id.Name
 
 	resp, err := client.Get(resGroup, vmName, name, "")
 
@@ -179,23 +193,26 @@ func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interf
 		d.Set("settings", settings)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmExtensionClient
+	client := meta.(*ArmClient).vmExtensionClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineExtensionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["extensions"]
-	vmName := id.Path["virtualMachines"]
+	name := id.Name
+	vmName := id.VirtualMachineName
 
-	_, error := client.Delete(resGroup, vmName, name, make(chan struct{}))
+	_, error := client.Delete(resGroup, vmName, name, ctx.Done())
 	err = <-error
 
 	return err
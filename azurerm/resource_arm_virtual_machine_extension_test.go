@@ -6,14 +6,13 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMVirtualMachineExtension_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_extension.test"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMVirtualMachineExtension_basic(ri, location)
 	postConfig := testAccAzureRMVirtualMachineExtension_basicUpdate(ri, location)
@@ -44,7 +43,7 @@ func TestAccAzureRMVirtualMachineExtension_basic(t *testing.T) {
 func TestAccAzureRMVirtualMachineExtension_concurrent(t *testing.T) {
 	firstResourceName := "azurerm_virtual_machine_extension.test"
 	secondResourceName := "azurerm_virtual_machine_extension.test2"
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachineExtension_concurrent(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -66,7 +65,7 @@ func TestAccAzureRMVirtualMachineExtension_concurrent(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualMachineExtension_linuxDiagnostics(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachineExtension_linuxDiagnostics(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -96,7 +95,7 @@ func testCheckAzureRMVirtualMachineExtensionExists(name string) resource.TestChe
 		vmName := rs.Primary.Attributes["virtual_machine_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+		conn := testAccProvider.Meta().(*ArmClient).vmExtensionClient()
 
 		resp, err := conn.Get(resourceGroup, vmName, name, "")
 		if err != nil {
@@ -112,7 +111,7 @@ func testCheckAzureRMVirtualMachineExtensionExists(name string) resource.TestChe
 }
 
 func testCheckAzureRMVirtualMachineExtensionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+	conn := testAccProvider.Meta().(*ArmClient).vmExtensionClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_virtual_machine_extension" {
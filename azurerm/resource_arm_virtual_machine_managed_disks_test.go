@@ -3,20 +3,20 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/azure-sdk-for-go/arm/disk"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(t *testing.T) {
 	var vm compute.VirtualMachine
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -35,7 +35,7 @@ func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(t *test
 
 func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_implicit(t *testing.T) {
 	var vm compute.VirtualMachine
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_implicit(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -52,9 +52,295 @@ func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_implicit(t *test
 	})
 }
 
+func TestResourceAzureRMVirtualMachine_fakeArmResize(t *testing.T) {
+	resourceName := "azurerm_virtual_machine.test"
+	ri := acctRandInt(t)
+	preConfig := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	postConfig := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D2_v2")
+	vmId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Compute/virtualMachines/acctvm-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	// the Virtual Machine is deallocated at its original size and started at the new one,
+	// with its disks and network interfaces still attached
+	var sizes []string
+	recordSize := func(action string, powerState string) fakeArmAction {
+		return func(vm map[string]interface{}) interface{} {
+			properties := vm["properties"].(map[string]interface{})
+			size := properties["hardwareProfile"].(map[string]interface{})["vmSize"].(string)
+			dataDisks := properties["storageProfile"].(map[string]interface{})["dataDisks"].([]interface{})
+			nics := properties["networkProfile"].(map[string]interface{})["networkInterfaces"].([]interface{})
+			sizes = append(sizes, fmt.Sprintf("%s %s (%d data disks, %d nics)", action, size, len(dataDisks), len(nics)))
+			return fakeArmPowerStateAction(powerState)(vm)
+		}
+	}
+	server.handleAction("Microsoft.Compute/virtualMachines", "deallocate", recordSize("deallocate", "deallocated"))
+	server.handleAction("Microsoft.Compute/virtualMachines", "start", recordSize("start", "running"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vm_size", "Standard_D1_v2"),
+				),
+			},
+			{
+				// Standard_D2_v2 isn't available on the current hardware cluster
+				PreConfig: func() {
+					server.put(vmId+"/vmSizes/Standard_D1_v2", map[string]interface{}{
+						"name": "Standard_D1_v2",
+					})
+				},
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vm_size", "Standard_D2_v2"),
+					func(s *terraform.State) error {
+						expected := []string{
+							"deallocate Standard_D1_v2 (1 data disks, 1 nics)",
+							"start Standard_D2_v2 (1 data disks, 1 nics)",
+						}
+						if !reflect.DeepEqual(expected, sizes) {
+							return fmt.Errorf("Expected the Virtual Machine to be resized by %+v but got %+v", expected, sizes)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Standard_D1_v2 is available, so the Virtual Machine is resized without deallocating it
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vm_size", "Standard_D1_v2"),
+					func(s *terraform.State) error {
+						if len(sizes) != 2 {
+							return fmt.Errorf("Expected the Virtual Machine not to be deallocated but got %+v", sizes)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMVirtualMachine_fakeArmResizeDeallocated(t *testing.T) {
+	resourceName := "azurerm_virtual_machine.test"
+	ri := acctRandInt(t)
+	preConfig := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	postConfig := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D2_v2")
+	vmId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Compute/virtualMachines/acctvm-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.handleAction("Microsoft.Compute/virtualMachines", "deallocate", fakeArmPowerStateAction("deallocated"))
+	server.handleAction("Microsoft.Compute/virtualMachines", "start", fakeArmPowerStateAction("running"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check:  server.checkExists(resourceName),
+			},
+			{
+				// Standard_D2_v2 isn't available on the current hardware cluster
+				PreConfig: func() {
+					server.put(vmId+"/vmSizes/Standard_D1_v2", map[string]interface{}{
+						"name": "Standard_D1_v2",
+					})
+				},
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					func(s *terraform.State) error {
+						// the Virtual Machine is only resized once it's deallocated, and started afterwards
+						expected := []string{
+							"PUT " + vmId,
+							"POST " + vmId + "/deallocate",
+							"PUT " + vmId,
+							"POST " + vmId + "/start",
+						}
+						if actual := server.changesFor(vmId); !reflect.DeepEqual(expected, actual) {
+							return fmt.Errorf("Expected the Virtual Machine to be changed by %+v but got %+v", expected, actual)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMVirtualMachine_fakeArmPowerState(t *testing.T) {
+	resourceName := "azurerm_virtual_machine.test"
+	ri := acctRandInt(t)
+	config := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	withPowerState := func(powerState string) string {
+		return strings.Replace(config, "    vm_size", fmt.Sprintf("    power_state = %q\n    vm_size", powerState), 1)
+	}
+	vmId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Compute/virtualMachines/acctvm-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.handleAction("Microsoft.Compute/virtualMachines", "start", fakeArmPowerStateAction("running"))
+	server.handleAction("Microsoft.Compute/virtualMachines", "powerOff", fakeArmPowerStateAction("stopped"))
+	server.handleAction("Microsoft.Compute/virtualMachines", "deallocate", fakeArmPowerStateAction("deallocated"))
+
+	checkActions := func(start int, powerOff int, deallocate int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			actual := []int{
+				server.requestsFor(http.MethodPost, vmId+"/start"),
+				server.requestsFor(http.MethodPost, vmId+"/powerOff"),
+				server.requestsFor(http.MethodPost, vmId+"/deallocate"),
+			}
+			expected := []int{start, powerOff, deallocate}
+			if !reflect.DeepEqual(expected, actual) {
+				return fmt.Errorf("Expected the Virtual Machine to be started/stopped/deallocated %+v times but got %+v", expected, actual)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				Config: withPowerState("deallocated"),
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "deallocated"),
+					checkActions(0, 0, 1),
+				),
+			},
+			{
+				// a deallocated Virtual Machine is started before it's stopped
+				Config: withPowerState("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "stopped"),
+					checkActions(1, 1, 1),
+				),
+			},
+			{
+				Config: withPowerState("Running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "running"),
+					checkActions(2, 1, 1),
+				),
+			},
+			{
+				// the power state is left as-is when it's not specified
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "running"),
+					checkActions(2, 1, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachine_identity(t *testing.T) {
+	var vm compute.VirtualMachine
+	resourceName := "azurerm_virtual_machine.test"
+	ri := acctRandInt(t)
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_implicit(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_withIdentity(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestMatchResourceAttr(resourceName, "identity.0.principal_id", regexp.MustCompile(".+")),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_implicit(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMVirtualMachine_fakeArmIdentity(t *testing.T) {
+	resourceName := "azurerm_virtual_machine.test"
+	ri := acctRandInt(t)
+	config := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	withIdentity := strings.Replace(config, "    vm_size", "    identity {\n        type = \"SystemAssigned\"\n    }\n\n    vm_size", 1)
+	vmId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Compute/virtualMachines/acctvm-%d", fakeArmSubscriptionID, ri, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+				),
+			},
+			{
+				// the identity is added in-place
+				Config: withIdentity,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestMatchResourceAttr(resourceName, "identity.0.principal_id", regexp.MustCompile(".+")),
+				),
+			},
+			{
+				// and removed in-place
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					func(s *terraform.State) error {
+						vm, _ := server.get(vmId)
+						if _, ok := vm["identity"]; ok {
+							return fmt.Errorf("Expected the identity to be removed from the Virtual Machine but got %+v", vm["identity"])
+						}
+						if server.requestsFor(http.MethodDelete, vmId) != 0 {
+							return fmt.Errorf("Expected the Virtual Machine to be updated in-place rather than recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_attach(t *testing.T) {
 	var vm compute.VirtualMachine
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_attach(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -74,7 +360,7 @@ func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_attach(t *testin
 func TestAccAzureRMVirtualMachine_withDataDisk_managedDisk_explicit(t *testing.T) {
 	var vm compute.VirtualMachine
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_withDataDisk_managedDisk_explicit(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -94,7 +380,7 @@ func TestAccAzureRMVirtualMachine_withDataDisk_managedDisk_explicit(t *testing.T
 func TestAccAzureRMVirtualMachine_withDataDisk_managedDisk_implicit(t *testing.T) {
 	var vm compute.VirtualMachine
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_withDataDisk_managedDisk_implicit(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -115,7 +401,7 @@ func TestAccAzureRMVirtualMachine_deleteManagedDiskOptOut(t *testing.T) {
 	var vm compute.VirtualMachine
 	var osd string
 	var dtd string
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMVirtualMachine_withDataDisk_managedDisk_implicit(ri, location)
 	postConfig := testAccAzureRMVirtualMachine_basicLinuxMachineDeleteVM_managedDisk(ri, location)
@@ -148,7 +434,7 @@ func TestAccAzureRMVirtualMachine_deleteManagedDiskOptIn(t *testing.T) {
 	var vm compute.VirtualMachine
 	var osd string
 	var dtd string
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_DestroyDisksBefore(ri, location)
 	postConfig := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_DestroyDisksAfter(ri, location)
@@ -177,8 +463,156 @@ func TestAccAzureRMVirtualMachine_deleteManagedDiskOptIn(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMVirtualMachine_fakeArmDeleteNetworkInterfaces(t *testing.T) {
+	ri := acctRandInt(t)
+	config := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	preConfig := strings.Replace(config, "    vm_size", "    delete_network_interfaces_on_termination = true\n    vm_size", 1)
+	postConfig := strings.Split(config, `resource "azurerm_virtual_machine"`)[0]
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakeArmSubscriptionID, ri)
+	nicId := fmt.Sprintf("%s/providers/Microsoft.Network/networkInterfaces/acctni-%d", resourceGroupId, ri)
+	publicIPId := fmt.Sprintf("%s/providers/Microsoft.Network/publicIPAddresses/acctpip-%d", resourceGroupId, ri)
+	otherPublicIPId := fmt.Sprintf("%s/providers/Microsoft.Network/publicIPAddresses/acctpip2-%d", resourceGroupId, ri)
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				// the Network Interface is created outside of Terraform, as it would be by a build system
+				PreConfig: func() {
+					server.put(nicId, map[string]interface{}{
+						"id":   nicId,
+						"name": fmt.Sprintf("acctni-%d", ri),
+						"properties": map[string]interface{}{
+							"ipConfigurations": []interface{}{
+								map[string]interface{}{
+									"name": "testconfiguration1",
+									"properties": map[string]interface{}{
+										"subnet": map[string]interface{}{
+											"id": fmt.Sprintf("%s/providers/Microsoft.Network/virtualNetworks/acctvn-%d/subnets/acctsub-%d", resourceGroupId, ri, ri),
+										},
+										"publicIPAddress": map[string]interface{}{
+											"id": publicIPId,
+										},
+									},
+								},
+							},
+						},
+					})
+					server.put(publicIPId, map[string]interface{}{
+						"id":   publicIPId,
+						"name": fmt.Sprintf("acctpip-%d", ri),
+					})
+					server.put(otherPublicIPId, map[string]interface{}{
+						"id":   otherPublicIPId,
+						"name": fmt.Sprintf("acctpip2-%d", ri),
+					})
+				},
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists("azurerm_virtual_machine.test"),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine.test", "delete_network_interfaces_on_termination", "true"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: func(s *terraform.State) error {
+					if _, ok := server.get(nicId); ok {
+						return fmt.Errorf("Expected the Network Interface %q to be deleted with the Virtual Machine", nicId)
+					}
+					if _, ok := server.get(publicIPId); ok {
+						return fmt.Errorf("Expected the Public IP %q to be deleted with the Network Interface", publicIPId)
+					}
+					if _, ok := server.get(otherPublicIPId); !ok {
+						return fmt.Errorf("Expected the Public IP %q to be left as-is, since it's not associated with the Network Interface", otherPublicIPId)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMVirtualMachine_fakeArmDeleteMultipleIPConfigurations(t *testing.T) {
+	ri := acctRandInt(t)
+	config := testResourceAzureRMVirtualMachine_fakeArmResize(ri, "Standard_D1_v2")
+	preConfig := strings.Replace(config, "    vm_size", "    delete_network_interfaces_on_termination = true\n    vm_size", 1)
+	postConfig := strings.Split(config, `resource "azurerm_virtual_machine"`)[0]
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakeArmSubscriptionID, ri)
+	nicId := fmt.Sprintf("%s/providers/Microsoft.Network/networkInterfaces/acctni-%d", resourceGroupId, ri)
+	subnetId := fmt.Sprintf("%s/providers/Microsoft.Network/virtualNetworks/acctvn-%d/subnets/acctsub-%d", resourceGroupId, ri, ri)
+	publicIPIds := []string{
+		fmt.Sprintf("%s/providers/Microsoft.Network/publicIPAddresses/acctpip1-%d", resourceGroupId, ri),
+		fmt.Sprintf("%s/providers/Microsoft.Network/publicIPAddresses/acctpip2-%d", resourceGroupId, ri),
+	}
+
+	server := newFakeArmServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_machine"),
+		Steps: []resource.TestStep{
+			{
+				// both IP Configurations are within the same Subnet, whose name is only locked once
+				PreConfig: func() {
+					ipConfigurations := make([]interface{}, 0)
+					for i, publicIPId := range publicIPIds {
+						ipConfigurations = append(ipConfigurations, map[string]interface{}{
+							"name": fmt.Sprintf("testconfiguration%d", i+1),
+							"properties": map[string]interface{}{
+								"subnet": map[string]interface{}{
+									"id": subnetId,
+								},
+								"publicIPAddress": map[string]interface{}{
+									"id": publicIPId,
+								},
+							},
+						})
+						server.put(publicIPId, map[string]interface{}{
+							"id":   publicIPId,
+							"name": fmt.Sprintf("acctpip%d-%d", i+1, ri),
+						})
+					}
+
+					server.put(nicId, map[string]interface{}{
+						"id":   nicId,
+						"name": fmt.Sprintf("acctni-%d", ri),
+						"properties": map[string]interface{}{
+							"ipConfigurations": ipConfigurations,
+						},
+					})
+				},
+				Config: preConfig,
+				Check:  server.checkExists("azurerm_virtual_machine.test"),
+			},
+			{
+				Config: postConfig,
+				Check: func(s *terraform.State) error {
+					if _, ok := server.get(nicId); ok {
+						return fmt.Errorf("Expected the Network Interface %q to be deleted with the Virtual Machine", nicId)
+					}
+					for _, publicIPId := range publicIPIds {
+						if _, ok := server.get(publicIPId); ok {
+							return fmt.Errorf("Expected the Public IP %q to be deleted with the Network Interface", publicIPId)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachine_osDiskTypeConflict(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_osDiskTypeConflict(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -194,7 +628,7 @@ func TestAccAzureRMVirtualMachine_osDiskTypeConflict(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualMachine_dataDiskTypeConflict(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualMachine_dataDiskTypeConflict(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -210,8 +644,8 @@ func TestAccAzureRMVirtualMachine_dataDiskTypeConflict(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualMachine_bugAzureRM33(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(7)
+	ri := acctRandInt(t)
+	rs := acctRandString(t, 7)
 	config := testAccAzureRMVirtualMachine_bugAzureRM33(ri, rs, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -370,6 +804,82 @@ resource "azurerm_virtual_machine" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
 }
 
+func testAccAzureRMVirtualMachine_withIdentity(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctvn-%d"
+    address_space = ["10.0.0.0/16"]
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctsub-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+    name = "acctni-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    ip_configuration {
+    	name = "testconfiguration1"
+    	subnet_id = "${azurerm_subnet.test.id}"
+    	private_ip_address_allocation = "dynamic"
+    }
+}
+
+resource "azurerm_virtual_machine" "test" {
+    name = "acctvm-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    network_interface_ids = ["${azurerm_network_interface.test.id}"]
+    vm_size = "Standard_D1_v2"
+
+    identity {
+        type = "SystemAssigned"
+    }
+
+    storage_image_reference {
+	publisher = "Canonical"
+	offer = "UbuntuServer"
+	sku = "14.04.2-LTS"
+	version = "latest"
+    }
+
+    storage_os_disk {
+        name = "osd-%d"
+        caching = "ReadWrite"
+        create_option = "FromImage"
+        disk_size_gb = "50"
+    }
+
+    os_profile {
+	computer_name = "hn%d"
+	admin_username = "testadmin"
+	admin_password = "Password1234!"
+    }
+
+    os_profile_linux_config {
+	disable_password_authentication = false
+    }
+
+    tags {
+    	environment = "Production"
+    	cost-center = "Ops"
+    }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
 func testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_attach(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -772,6 +1282,55 @@ resource "azurerm_virtual_machine" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt)
 }
 
+// the fake ARM server doesn't allocate IP Addresses, so the Network Interface is referenced by its ID
+func testResourceAzureRMVirtualMachine_fakeArmResize(rInt int, vmSize string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_machine" "test" {
+    name = "acctvm-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    network_interface_ids = ["${azurerm_resource_group.test.id}/providers/Microsoft.Network/networkInterfaces/acctni-%d"]
+    vm_size = "%s"
+
+    storage_image_reference {
+	publisher = "Canonical"
+	offer = "UbuntuServer"
+	sku = "14.04.2-LTS"
+	version = "latest"
+    }
+
+    storage_os_disk {
+        name = "myosdisk1"
+        caching = "ReadWrite"
+        create_option = "FromImage"
+    }
+
+    storage_data_disk {
+        name          = "mydatadisk1"
+    	disk_size_gb  = "1"
+    	create_option = "Empty"
+        caching       = "ReadWrite"
+    	lun           = 0
+    }
+
+    os_profile {
+	computer_name = "hn%d"
+	admin_username = "testadmin"
+	admin_password = "Password1234!"
+    }
+
+    os_profile_linux_config {
+	disable_password_authentication = false
+    }
+}
+`, rInt, rInt, rInt, vmSize, rInt)
+}
+
 func testAccAzureRMVirtualMachine_osDiskTypeConflict(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1081,7 +1640,7 @@ func testGetAzureRMVirtualMachineManagedDisk(managedDiskID *string) (*disk.Model
 	}
 	name := armID.Path["disks"]
 	resourceGroup := armID.ResourceGroup
-	conn := testAccProvider.Meta().(*ArmClient).diskClient
+	conn := testAccProvider.Meta().(*ArmClient).diskClient()
 	d, err := conn.Get(resourceGroup, name)
 	//check status first since sdk client returns error if not 200
 	if d.Response.StatusCode == http.StatusNotFound {
@@ -2,16 +2,18 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
-	riviera "github.com/jen20/riviera/azure"
 )
 
 func resourceArmVirtualMachineScaleSet() *schema.Resource {
@@ -24,6 +26,12 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -495,6 +503,29 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Set: resourceArmVirtualMachineScaleSetExtensionHash,
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.SystemAssigned),
+							}, true),
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -502,14 +533,20 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	vmScaleSetClient := client.vmScaleSetClient
+	vmScaleSetClient := client.vmScaleSetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 
 	sku, err := expandVirtualMachineScaleSetSku(d)
 	if err != nil {
@@ -584,7 +621,9 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		scaleSetParams.Plan = plan
 	}
 
-	_, vmError := vmScaleSetClient.CreateOrUpdate(resGroup, name, scaleSetParams, make(chan struct{}))
+	scaleSetParams.Identity = expandAzureRmVirtualMachineScaleSetIdentity(d)
+
+	_, vmError := vmScaleSetClient.CreateOrUpdate(resGroup, name, scaleSetParams, ctx.Done())
 	vmErr := <-vmError
 	if vmErr != nil {
 		return vmErr
@@ -604,14 +643,14 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
-	vmScaleSetClient := meta.(*ArmClient).vmScaleSetClient
+	vmScaleSetClient := meta.(*ArmClient).vmScaleSetClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
 	resp, err := vmScaleSetClient.Get(resGroup, name)
 	if err != nil {
@@ -631,6 +670,10 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Sku error: %#v", err)
 	}
 
+	if err := d.Set("identity", flattenAzureRmVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Identity: %#v", err)
+	}
+
 	properties := resp.VirtualMachineScaleSetProperties
 
 	d.Set("upgrade_policy_mode", properties.UpgradePolicy.Mode)
@@ -698,22 +741,25 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
-	vmScaleSetClient := meta.(*ArmClient).vmScaleSetClient
+	vmScaleSetClient := meta.(*ArmClient).vmScaleSetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
-	_, error := vmScaleSetClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := vmScaleSetClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -1354,7 +1400,7 @@ func expandAzureRmVirtualMachineScaleSetStorageProfileImageReference(d *schema.R
 	}
 
 	if imageID != "" {
-		imageReference.ID = riviera.String(storageImageRef["id"].(string))
+		imageReference.ID = to.StringPtr(storageImageRef["id"].(string))
 	} else {
 		offer := storageImageRef["offer"].(string)
 		sku := storageImageRef["sku"].(string)
@@ -1579,3 +1625,36 @@ func flattenAzureRmVirtualMachineScaleSetPlan(plan *compute.Plan) []interface{}
 
 	return []interface{}{result}
 }
+
+func expandAzureRmVirtualMachineScaleSetIdentity(d *schema.ResourceData) *compute.VirtualMachineScaleSetIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
+		// the identity is only removed when it's explicitly disabled, rather than omitted from the PUT
+		if !d.IsNewResource() && d.HasChange("identity") {
+			return &compute.VirtualMachineScaleSetIdentity{
+				Type: compute.ResourceIdentityType("None"),
+			}
+		}
+
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	return &compute.VirtualMachineScaleSetIdentity{
+		Type: compute.ResourceIdentityType(identity["type"].(string)),
+	}
+}
+
+func flattenAzureRmVirtualMachineScaleSetIdentity(identity *compute.VirtualMachineScaleSetIdentity) []interface{} {
+	if identity == nil || strings.EqualFold(string(identity.Type), "None") {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+	if identity.PrincipalID != nil {
+		result["principal_id"] = *identity.PrincipalID
+	}
+
+	return []interface{}{result}
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
@@ -9,6 +10,47 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_virtual_machine", &resource.Sweeper{
+		Name: "azurerm_virtual_machine",
+		F:    testSweepVirtualMachines,
+	})
+}
+
+func testSweepVirtualMachines(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.vmClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Virtual Machines..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Virtual Machines: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, vm := range *results.Value {
+				resources = append(resources, sweepableResource{vm.ID, vm.Name, vm.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Virtual Machine", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func testCheckAzureRMVirtualMachineExists(name string, vm *compute.VirtualMachine) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -23,7 +65,7 @@ func testCheckAzureRMVirtualMachineExists(name string, vm *compute.VirtualMachin
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", vmName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).vmClient
+		conn := testAccProvider.Meta().(*ArmClient).vmClient()
 
 		resp, err := conn.Get(resourceGroup, vmName, "")
 		if err != nil {
@@ -41,7 +83,7 @@ func testCheckAzureRMVirtualMachineExists(name string, vm *compute.VirtualMachin
 }
 
 func testCheckAzureRMVirtualMachineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).vmClient
+	conn := testAccProvider.Meta().(*ArmClient).vmClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_virtual_machine" {
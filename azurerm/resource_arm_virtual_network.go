@@ -2,9 +2,11 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -14,7 +16,7 @@ import (
 var virtualNetworkResourceName = "azurerm_virtual_network"
 
 func resourceArmVirtualNetwork() *schema.Resource {
-	return &schema.Resource{
+	return withSubscriptionOverride(&schema.Resource{
 		Create: resourceArmVirtualNetworkCreate,
 		Read:   resourceArmVirtualNetworkRead,
 		Update: resourceArmVirtualNetworkCreate,
@@ -23,6 +25,12 @@ func resourceArmVirtualNetwork() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -79,19 +87,25 @@ func resourceArmVirtualNetwork() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	vnetClient := client.vnetClient
+	vnetClient := client.vnetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		return err
+	}
 	vnetProperties, vnetPropsErr := getVirtualNetworkProperties(d, meta)
 	if vnetPropsErr != nil {
 		return vnetPropsErr
@@ -119,8 +133,8 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	_, error := vnetClient.CreateOrUpdate(resGroup, name, vnet, make(chan struct{}))
-	err := <-error
+	_, error := vnetClient.CreateOrUpdate(resGroup, name, vnet, ctx.Done())
+	err = <-error
 	if err != nil {
 		return err
 	}
@@ -139,14 +153,14 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	vnetClient := meta.(*ArmClient).vnetClient
+	vnetClient := meta.(*ArmClient).vnetClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualNetworks"]
+	name := id.Name
 
 	resp, err := vnetClient.Get(resGroup, name, "")
 	if err != nil {
@@ -190,20 +204,23 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("dns_servers", dnses)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, removeDefaultTags(d, meta, resp.Tags))
 
 	return nil
 }
 
 func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
-	vnetClient := meta.(*ArmClient).vnetClient
+	vnetClient := meta.(*ArmClient).vnetClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualNetworks"]
+	name := id.Name
 
 	nsgNames, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d)
 	if err != nil {
@@ -213,7 +230,7 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&nsgNames, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&nsgNames, virtualNetworkResourceName)
 
-	_, error := vnetClient.Delete(resGroup, name, make(chan struct{}))
+	_, error := vnetClient.Delete(resGroup, name, ctx.Done())
 	err = <-error
 
 	return err
@@ -300,7 +317,7 @@ func resourceAzureSubnetHash(v interface{}) int {
 func getExistingSubnet(resGroup string, vnetName string, subnetName string, meta interface{}) (*network.Subnet, error) {
 	//attempt to retrieve existing subnet from the server
 	existingSubnet := network.Subnet{}
-	subnetClient := meta.(*ArmClient).subnetClient
+	subnetClient := meta.(*ArmClient).subnetClient()
 	resp, err := subnetClient.Get(resGroup, vnetName, subnetName, "")
 
 	if err != nil {
@@ -1,9 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,7 +16,7 @@ import (
 var peerMutex = &sync.Mutex{}
 
 func resourceArmVirtualNetworkPeering() *schema.Resource {
-	return &schema.Resource{
+	return withSubscriptionOverride(&schema.Resource{
 		Create: resourceArmVirtualNetworkPeeringCreate,
 		Read:   resourceArmVirtualNetworkPeeringRead,
 		Update: resourceArmVirtualNetworkPeeringCreate,
@@ -23,6 +25,12 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -72,11 +80,14 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
+	client := meta.(*ArmClient).vnetPeeringsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, createOrUpdateTimeout(d))
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network peering creation.")
 
@@ -92,7 +103,7 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	_, error := client.CreateOrUpdate(resGroup, vnetName, name, peer, make(chan struct{}))
+	_, error := client.CreateOrUpdate(resGroup, vnetName, name, peer, ctx.Done())
 	err := <-error
 	if err != nil {
 		return err
@@ -112,15 +123,15 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
+	client := meta.(*ArmClient).vnetPeeringsClient()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualNetworkPeeringID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(resGroup, vnetName, name)
 	if err != nil {
@@ -147,20 +158,23 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
+	client := meta.(*ArmClient).vnetPeeringsClient()
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseVirtualNetworkPeeringID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	_, error := client.Delete(resGroup, vnetName, name, make(chan struct{}))
+	_, error := client.Delete(resGroup, vnetName, name, ctx.Done())
 	err = <-error
 
 	return err
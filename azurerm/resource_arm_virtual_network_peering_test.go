@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,7 +13,7 @@ func TestAccAzureRMVirtualNetworkPeering_basic(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_peering.test1"
 	secondResourceName := "azurerm_virtual_network_peering.test2"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualNetworkPeering_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -39,7 +38,7 @@ func TestAccAzureRMVirtualNetworkPeering_disappears(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_peering.test1"
 	secondResourceName := "azurerm_virtual_network_peering.test2"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualNetworkPeering_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -66,7 +65,7 @@ func TestAccAzureRMVirtualNetworkPeering_update(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_peering.test1"
 	secondResourceName := "azurerm_virtual_network_peering.test2"
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMVirtualNetworkPeering_basic(ri, testLocation())
 	postConfig := testAccAzureRMVirtualNetworkPeering_basicUpdate(ri, testLocation())
 
@@ -118,7 +117,7 @@ func testCheckAzureRMVirtualNetworkPeeringExists(name string) resource.TestCheck
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+		conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient()
 
 		resp, err := conn.Get(resourceGroup, vnetName, name)
 		if err != nil {
@@ -149,7 +148,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(name string) resource.TestC
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+		conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient()
 
 		_, error := conn.Delete(resourceGroup, vnetName, name, make(chan struct{}))
 		err := <-error
@@ -162,7 +161,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(name string) resource.TestC
 }
 
 func testCheckAzureRMVirtualNetworkPeeringDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+	conn := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_virtual_network_peering" {
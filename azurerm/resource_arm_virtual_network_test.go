@@ -2,16 +2,63 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_virtual_network", &resource.Sweeper{
+		Name: "azurerm_virtual_network",
+		F:    testSweepVirtualNetworks,
+		Dependencies: []string{
+			"azurerm_container_service",
+			"azurerm_lb",
+			"azurerm_network_interface",
+			"azurerm_virtual_machine_scale_set",
+		},
+	})
+}
+
+func testSweepVirtualNetworks(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := armClient.vnetClient()
+	resources := make([]sweepableResource, 0)
+
+	log.Printf("Retrieving the Virtual Networks..")
+	results, err := client.ListAll()
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing Virtual Networks: %+v", err)
+		}
+
+		if results.Value != nil {
+			for _, network := range *results.Value {
+				resources = append(resources, sweepableResource{network.ID, network.Name, network.Location})
+			}
+		}
+
+		if results.NextLink == nil || *results.NextLink == "" {
+			break
+		}
+		results, err = client.ListAllNextResults(results)
+	}
+
+	return sweepAcceptanceTestResources(region, "Virtual Network", resources, func(resourceGroup string, name string) error {
+		_, errors := client.Delete(resourceGroup, name, make(chan struct{}))
+		return <-errors
+	})
+}
+
 func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualNetwork_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +78,7 @@ func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 
 func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	config := testAccAzureRMVirtualNetwork_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -53,7 +100,7 @@ func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 
 func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := acctRandInt(t)
 	preConfig := testAccAzureRMVirtualNetwork_withTags(ri, testLocation())
 	postConfig := testAccAzureRMVirtualNetwork_withTagsUpdated(ri, testLocation())
 
@@ -88,6 +135,36 @@ func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMVirtualNetwork_fakeArm(t *testing.T) {
+	resourceName := "azurerm_virtual_network.test"
+	ri := acctRandInt(t)
+	config := testAccAzureRMVirtualNetwork_basic(ri, "West US")
+
+	server := newFakeArmServer()
+	defer server.Close()
+	server.longRunning("Microsoft.Network/virtualNetworks", http.MethodPut, http.MethodDelete)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    server.providers(),
+		CheckDestroy: server.checkDestroy("azurerm_virtual_network"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMVirtualNetworkExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -103,7 +180,7 @@ func testCheckAzureRMVirtualNetworkExists(name string) resource.TestCheckFunc {
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).vnetClient
+		conn := testAccProvider.Meta().(*ArmClient).vnetClient()
 
 		resp, err := conn.Get(resourceGroup, virtualNetworkName, "")
 		if err != nil {
@@ -133,7 +210,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).vnetClient
+		conn := testAccProvider.Meta().(*ArmClient).vnetClient()
 
 		_, error := conn.Delete(resourceGroup, virtualNetworkName, make(chan struct{}))
 		err := <-error
@@ -146,7 +223,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMVirtualNetworkDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).vnetClient
+	conn := testAccProvider.Meta().(*ArmClient).vnetClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_virtual_network" {
@@ -18,26 +18,37 @@ type ResourceID struct {
 	Path           map[string]string
 }
 
-// parseAzureResourceID converts a long-form Azure Resource Manager ID
-// into a ResourceID. We make assumptions about the structure of URLs,
-// which is obviously not good, but the best thing available given the
-// SDK.
-func parseAzureResourceID(id string) (*ResourceID, error) {
+// resourceIDSegment is a key-value pair within the path of a Resource ID
+// beneath the Resource Provider, such as `virtualNetworks/network1`.
+type resourceIDSegment struct {
+	Key   string
+	Value string
+}
+
+// resourceIDComponents represents a long-form Azure Resource Manager ID
+// split into its components, with the segments beneath the Resource
+// Provider kept in the order they appear in the ID.
+type resourceIDComponents struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Provider       string
+	Segments       []resourceIDSegment
+}
+
+// splitAzureResourceID splits a long-form Azure Resource Manager ID into
+// its components. The ID must start with the Subscription ID and the
+// Resource Group, optionally followed by the Resource Provider and the
+// segments beneath it - the names of which are matched case-insensitively,
+// since ARM isn't consistent about the casing it returns.
+func splitAzureResourceID(id string) (*resourceIDComponents, error) {
 	idURL, err := url.ParseRequestURI(id)
 	if err != nil {
 		return nil, fmt.Errorf("Cannot parse Azure Id: %s", err)
 	}
 
-	path := idURL.Path
-
-	path = strings.TrimSpace(path)
-	if strings.HasPrefix(path, "/") {
-		path = path[1:]
-	}
-
-	if strings.HasSuffix(path, "/") {
-		path = path[:len(path)-1]
-	}
+	path := strings.TrimSpace(idURL.Path)
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
 
 	components := strings.Split(path, "/")
 
@@ -46,10 +57,7 @@ func parseAzureResourceID(id string) (*ResourceID, error) {
 		return nil, fmt.Errorf("The number of path segments is not divisible by 2 in %q", path)
 	}
 
-	var subscriptionID string
-
-	// Put the constituent key-value pairs into a map
-	componentMap := make(map[string]string, len(components)/2)
+	segments := make([]resourceIDSegment, 0, len(components)/2)
 	for current := 0; current < len(components); current += 2 {
 		key := components[current]
 		value := components[current+1]
@@ -59,49 +67,114 @@ func parseAzureResourceID(id string) (*ResourceID, error) {
 			return nil, fmt.Errorf("Key/Value cannot be empty strings. Key: '%s', Value: '%s'", key, value)
 		}
 
-		// Catch the subscriptionID before it can be overwritten by another "subscriptions"
-		// value in the ID which is the case for the Service Bus subscription resource
-		if key == "subscriptions" && subscriptionID == "" {
-			subscriptionID = value
-		} else {
-			componentMap[key] = value
-		}
+		segments = append(segments, resourceIDSegment{
+			Key:   key,
+			Value: value,
+		})
 	}
 
-	// Build up a ResourceID from the map
-	idObj := &ResourceID{}
-	idObj.Path = componentMap
-
-	if subscriptionID != "" {
-		idObj.SubscriptionID = subscriptionID
-	} else {
+	if len(segments) < 1 || !strings.EqualFold(segments[0].Key, "subscriptions") {
 		return nil, fmt.Errorf("No subscription ID found in: %q", path)
 	}
 
-	if resourceGroup, ok := componentMap["resourceGroups"]; ok {
-		idObj.ResourceGroup = resourceGroup
-		delete(componentMap, "resourceGroups")
-	} else {
-		// Some Azure APIs are weird and provide things in lower case...
-		// However it's not clear whether the casing of other elements in the URI
-		// matter, so we explicitly look for that case here.
-		if resourceGroup, ok := componentMap["resourcegroups"]; ok {
-			idObj.ResourceGroup = resourceGroup
-			delete(componentMap, "resourcegroups")
-		} else {
-			return nil, fmt.Errorf("No resource group name found in: %q", path)
-		}
+	if len(segments) < 2 || !strings.EqualFold(segments[1].Key, "resourceGroups") {
+		return nil, fmt.Errorf("No resource group name found in: %q", path)
+	}
+
+	output := resourceIDComponents{
+		SubscriptionID: segments[0].Value,
+		ResourceGroup:  segments[1].Value,
+		Segments:       []resourceIDSegment{},
 	}
 
 	// It is OK not to have a provider in the case of a resource group
-	if provider, ok := componentMap["providers"]; ok {
-		idObj.Provider = provider
-		delete(componentMap, "providers")
+	if len(segments) > 2 {
+		if !strings.EqualFold(segments[2].Key, "providers") {
+			return nil, fmt.Errorf("Expected the Resource Provider to follow the resource group but got %q in: %q", segments[2].Key, path)
+		}
+
+		output.Provider = segments[2].Value
+		output.Segments = segments[3:]
+	}
+
+	return &output, nil
+}
+
+// parseAzureResourceID converts a long-form Azure Resource Manager ID
+// into a ResourceID. Where the type of resource is known, the typed
+// parser for that resource (e.g. parseVirtualNetworkID) should be used
+// instead - since that validates the segments of the ID.
+func parseAzureResourceID(id string) (*ResourceID, error) {
+	components, err := splitAzureResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Put the constituent key-value pairs into a map
+	componentMap := make(map[string]string, len(components.Segments))
+	for _, segment := range components.Segments {
+		componentMap[segment.Key] = segment.Value
+	}
+
+	idObj := &ResourceID{
+		SubscriptionID: components.SubscriptionID,
+		ResourceGroup:  components.ResourceGroup,
+		Provider:       components.Provider,
+		Path:           componentMap,
 	}
 
 	return idObj, nil
 }
 
+// resourceIDFormat describes the Resource ID of a type of resource: the
+// Resource Provider and the keys of the segments beneath it, in order.
+type resourceIDFormat struct {
+	// resourceType is the name of the type of resource, used in error messages
+	resourceType string
+	provider     string
+	keys         []string
+}
+
+// parse parses a Resource ID, validating that it matches this format. The
+// Resource Provider and the keys of each segment are matched case-insensitively.
+func (f resourceIDFormat) parse(input string) (*resourceIDComponents, error) {
+	id, err := splitAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s ID %q: %+v", f.resourceType, input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, f.provider) {
+		return nil, fmt.Errorf("Error parsing %s ID %q: expected the Resource Provider %q but got %q", f.resourceType, input, f.provider, id.Provider)
+	}
+
+	if len(id.Segments) != len(f.keys) {
+		return nil, fmt.Errorf("Error parsing %s ID %q: expected %d segments (%s) but got %d", f.resourceType, input, len(f.keys), strings.Join(f.keys, ", "), len(id.Segments))
+	}
+
+	for i, key := range f.keys {
+		if !strings.EqualFold(id.Segments[i].Key, key) {
+			return nil, fmt.Errorf("Error parsing %s ID %q: expected segment %d to be %q but got %q", f.resourceType, input, i+1, key, id.Segments[i].Key)
+		}
+		id.Segments[i].Key = key
+	}
+
+	return id, nil
+}
+
+// format returns the Resource ID for the specified values, one for each segment.
+func (f resourceIDFormat) format(subscriptionID, resourceGroup string, values ...string) string {
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, resourceGroup)
+	if f.provider != "" {
+		id += fmt.Sprintf("/providers/%s", f.provider)
+	}
+
+	for i, key := range f.keys {
+		id += fmt.Sprintf("/%s/%s", key, values[i])
+	}
+
+	return id
+}
+
 func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
 	if idObj.SubscriptionID == "" || idObj.ResourceGroup == "" {
 		return "", fmt.Errorf("SubscriptionID and ResourceGroup cannot be empty")
@@ -136,19 +209,19 @@ func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
 }
 
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
-	id, err := parseAzureResourceID(networkSecurityGroupId)
+	id, err := parseNetworkSecurityGroupID(networkSecurityGroupId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
 	}
 
-	return id.Path["networkSecurityGroups"], nil
+	return id.Name, nil
 }
 
 func parseRouteTableName(routeTableId string) (string, error) {
-	id, err := parseAzureResourceID(routeTableId)
+	id, err := parseRouteTableID(routeTableId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
 	}
 
-	return id.Path["routeTables"], nil
+	return id.Name, nil
 }
@@ -0,0 +1,31 @@
+package azurerm
+
+var applicationInsightsIDFormat = resourceIDFormat{
+	resourceType: "Application Insights",
+	provider:     "Microsoft.Insights",
+	keys:         []string{"components"},
+}
+
+// ApplicationInsightsID is the ID of an Application Insights.
+type ApplicationInsightsID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	id, err := applicationInsightsIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ApplicationInsightsID) String() string {
+	return applicationInsightsIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
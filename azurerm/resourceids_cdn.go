@@ -0,0 +1,63 @@
+package azurerm
+
+var cdnProfileIDFormat = resourceIDFormat{
+	resourceType: "CDN Profile",
+	provider:     "Microsoft.Cdn",
+	keys:         []string{"profiles"},
+}
+
+// CdnProfileID is the ID of a CDN Profile.
+type CdnProfileID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseCdnProfileID(input string) (*CdnProfileID, error) {
+	id, err := cdnProfileIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnProfileID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id CdnProfileID) String() string {
+	return cdnProfileIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var cdnEndpointIDFormat = resourceIDFormat{
+	resourceType: "CDN Endpoint",
+	provider:     "Microsoft.Cdn",
+	keys:         []string{"profiles", "endpoints"},
+}
+
+// CdnEndpointID is the ID of a CDN Endpoint.
+type CdnEndpointID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ProfileName    string
+	Name           string
+}
+
+func parseCdnEndpointID(input string) (*CdnEndpointID, error) {
+	id, err := cdnEndpointIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnEndpointID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ProfileName:    id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id CdnEndpointID) String() string {
+	return cdnEndpointIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.ProfileName, id.Name)
+}
@@ -0,0 +1,217 @@
+package azurerm
+
+var availabilitySetIDFormat = resourceIDFormat{
+	resourceType: "Availability Set",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"availabilitySets"},
+}
+
+// AvailabilitySetID is the ID of an Availability Set.
+type AvailabilitySetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, err := availabilitySetIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilitySetID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id AvailabilitySetID) String() string {
+	return availabilitySetIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var imageIDFormat = resourceIDFormat{
+	resourceType: "Image",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"images"},
+}
+
+// ImageID is the ID of an Image.
+type ImageID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseImageID(input string) (*ImageID, error) {
+	id, err := imageIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImageID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ImageID) String() string {
+	return imageIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var managedDiskIDFormat = resourceIDFormat{
+	resourceType: "Managed Disk",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"disks"},
+}
+
+// ManagedDiskID is the ID of a Managed Disk.
+type ManagedDiskID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, err := managedDiskIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedDiskID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ManagedDiskID) String() string {
+	return managedDiskIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var virtualMachineIDFormat = resourceIDFormat{
+	resourceType: "Virtual Machine",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"virtualMachines"},
+}
+
+// VirtualMachineID is the ID of a Virtual Machine.
+type VirtualMachineID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	id, err := virtualMachineIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id VirtualMachineID) String() string {
+	return virtualMachineIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// the Data Disks of a Virtual Machine aren't resources in their own right, but are given an ID within
+// the Virtual Machine so that they can be managed independently
+var virtualMachineDataDiskIDFormat = resourceIDFormat{
+	resourceType: "Virtual Machine Data Disk",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"virtualMachines", "dataDisks"},
+}
+
+// VirtualMachineDataDiskID is the ID of a Data Disk attached to a Virtual Machine.
+type VirtualMachineDataDiskID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+func parseVirtualMachineDataDiskID(input string) (*VirtualMachineDataDiskID, error) {
+	id, err := virtualMachineDataDiskIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineDataDiskID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		VirtualMachineName: id.Segments[0].Value,
+		Name:               id.Segments[1].Value,
+	}, nil
+}
+
+func (id VirtualMachineDataDiskID) String() string {
+	return virtualMachineDataDiskIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+var virtualMachineExtensionIDFormat = resourceIDFormat{
+	resourceType: "Virtual Machine Extension",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"virtualMachines", "extensions"},
+}
+
+// VirtualMachineExtensionID is the ID of a Virtual Machine Extension.
+type VirtualMachineExtensionID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+func parseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	id, err := virtualMachineExtensionIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineExtensionID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		VirtualMachineName: id.Segments[0].Value,
+		Name:               id.Segments[1].Value,
+	}, nil
+}
+
+func (id VirtualMachineExtensionID) String() string {
+	return virtualMachineExtensionIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+var virtualMachineScaleSetIDFormat = resourceIDFormat{
+	resourceType: "Virtual Machine Scale Set",
+	provider:     "Microsoft.Compute",
+	keys:         []string{"virtualMachineScaleSets"},
+}
+
+// VirtualMachineScaleSetID is the ID of a Virtual Machine Scale Set.
+type VirtualMachineScaleSetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	id, err := virtualMachineScaleSetIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id VirtualMachineScaleSetID) String() string {
+	return virtualMachineScaleSetIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+var containerRegistryIDFormat = resourceIDFormat{
+	resourceType: "Container Registry",
+	provider:     "Microsoft.ContainerRegistry",
+	keys:         []string{"registries"},
+}
+
+// ContainerRegistryID is the ID of a Container Registry.
+type ContainerRegistryID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	id, err := containerRegistryIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerRegistryID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ContainerRegistryID) String() string {
+	return containerRegistryIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
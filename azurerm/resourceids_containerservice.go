@@ -0,0 +1,31 @@
+package azurerm
+
+var containerServiceIDFormat = resourceIDFormat{
+	resourceType: "Container Service",
+	provider:     "Microsoft.ContainerService",
+	keys:         []string{"containerServices"},
+}
+
+// ContainerServiceID is the ID of a Container Service.
+type ContainerServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseContainerServiceID(input string) (*ContainerServiceID, error) {
+	id, err := containerServiceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerServiceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ContainerServiceID) String() string {
+	return containerServiceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
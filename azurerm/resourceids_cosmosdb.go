@@ -0,0 +1,31 @@
+package azurerm
+
+var cosmosDBAccountIDFormat = resourceIDFormat{
+	resourceType: "CosmosDB Account",
+	provider:     "Microsoft.DocumentDB",
+	keys:         []string{"databaseAccounts"},
+}
+
+// CosmosDBAccountID is the ID of a CosmosDB Account.
+type CosmosDBAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseCosmosDBAccountID(input string) (*CosmosDBAccountID, error) {
+	id, err := cosmosDBAccountIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id CosmosDBAccountID) String() string {
+	return cosmosDBAccountIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
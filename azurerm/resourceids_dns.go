@@ -0,0 +1,71 @@
+package azurerm
+
+import "fmt"
+
+var dnsZoneIDFormat = resourceIDFormat{
+	resourceType: "DNS Zone",
+	provider:     "Microsoft.Network",
+	keys:         []string{"dnszones"},
+}
+
+// DnsZoneID is the ID of a DNS Zone.
+type DnsZoneID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseDnsZoneID(input string) (*DnsZoneID, error) {
+	id, err := dnsZoneIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsZoneID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id DnsZoneID) String() string {
+	return dnsZoneIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// dnsRecordIDFormat returns the format of the ID of a DNS Record of the specified type
+// (e.g. `A` or `CNAME`), since the type of the record is used as the key of the segment.
+func dnsRecordIDFormat(recordType string) resourceIDFormat {
+	return resourceIDFormat{
+		resourceType: fmt.Sprintf("DNS %s Record", recordType),
+		provider:     "Microsoft.Network",
+		keys:         []string{"dnszones", recordType},
+	}
+}
+
+// DnsRecordID is the ID of a DNS Record within a DNS Zone.
+type DnsRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	RecordType     string
+	Name           string
+}
+
+func parseDnsRecordID(input string, recordType string) (*DnsRecordID, error) {
+	id, err := dnsRecordIDFormat(recordType).parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ZoneName:       id.Segments[0].Value,
+		RecordType:     recordType,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id DnsRecordID) String() string {
+	return dnsRecordIDFormat(id.RecordType).format(id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
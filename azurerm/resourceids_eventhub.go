@@ -0,0 +1,131 @@
+package azurerm
+
+var eventHubNamespaceIDFormat = resourceIDFormat{
+	resourceType: "EventHub Namespace",
+	provider:     "Microsoft.EventHub",
+	keys:         []string{"namespaces"},
+}
+
+// EventHubNamespaceID is the ID of an EventHub Namespace.
+type EventHubNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	id, err := eventHubNamespaceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id EventHubNamespaceID) String() string {
+	return eventHubNamespaceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var eventHubIDFormat = resourceIDFormat{
+	resourceType: "EventHub",
+	provider:     "Microsoft.EventHub",
+	keys:         []string{"namespaces", "eventhubs"},
+}
+
+// EventHubID is the ID of an EventHub.
+type EventHubID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+func parseEventHubID(input string) (*EventHubID, error) {
+	id, err := eventHubIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id EventHubID) String() string {
+	return eventHubIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+var eventHubAuthorizationRuleIDFormat = resourceIDFormat{
+	resourceType: "EventHub Authorization Rule",
+	provider:     "Microsoft.EventHub",
+	keys:         []string{"namespaces", "eventhubs", "authorizationRules"},
+}
+
+// EventHubAuthorizationRuleID is the ID of an EventHub Authorization Rule.
+type EventHubAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+func parseEventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleID, error) {
+	id, err := eventHubAuthorizationRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		EventHubName:   id.Segments[1].Value,
+		Name:           id.Segments[2].Value,
+	}, nil
+}
+
+func (id EventHubAuthorizationRuleID) String() string {
+	return eventHubAuthorizationRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+var eventHubConsumerGroupIDFormat = resourceIDFormat{
+	resourceType: "EventHub Consumer Group",
+	provider:     "Microsoft.EventHub",
+	keys:         []string{"namespaces", "eventhubs", "consumergroups"},
+}
+
+// EventHubConsumerGroupID is the ID of an EventHub Consumer Group.
+type EventHubConsumerGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+func parseEventHubConsumerGroupID(input string) (*EventHubConsumerGroupID, error) {
+	id, err := eventHubConsumerGroupIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubConsumerGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		EventHubName:   id.Segments[1].Value,
+		Name:           id.Segments[2].Value,
+	}, nil
+}
+
+func (id EventHubConsumerGroupID) String() string {
+	return eventHubConsumerGroupIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+var keyVaultIDFormat = resourceIDFormat{
+	resourceType: "Key Vault",
+	provider:     "Microsoft.KeyVault",
+	keys:         []string{"vaults"},
+}
+
+// KeyVaultID is the ID of a Key Vault.
+type KeyVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, err := keyVaultIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id KeyVaultID) String() string {
+	return keyVaultIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
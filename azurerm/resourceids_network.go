@@ -0,0 +1,561 @@
+package azurerm
+
+var expressRouteCircuitIDFormat = resourceIDFormat{
+	resourceType: "ExpressRoute Circuit",
+	provider:     "Microsoft.Network",
+	keys:         []string{"expressRouteCircuits"},
+}
+
+// ExpressRouteCircuitID is the ID of an ExpressRoute Circuit.
+type ExpressRouteCircuitID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, err := expressRouteCircuitIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ExpressRouteCircuitID) String() string {
+	return expressRouteCircuitIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var loadBalancerIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers"},
+}
+
+// LoadBalancerID is the ID of a Load Balancer.
+type LoadBalancerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	id, err := loadBalancerIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id LoadBalancerID) String() string {
+	return loadBalancerIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var loadBalancerBackendAddressPoolIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Backend Address Pool",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "backendAddressPools"},
+}
+
+// LoadBalancerBackendAddressPoolID is the ID of a Load Balancer Backend Address Pool.
+type LoadBalancerBackendAddressPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	id, err := loadBalancerBackendAddressPoolIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerBackendAddressPoolID) String() string {
+	return loadBalancerBackendAddressPoolIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var loadBalancerFrontendIPConfigurationIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Frontend IP Configuration",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "frontendIPConfigurations"},
+}
+
+// LoadBalancerFrontendIPConfigurationID is the ID of a Load Balancer Frontend IP Configuration.
+type LoadBalancerFrontendIPConfigurationID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerFrontendIPConfigurationID(input string) (*LoadBalancerFrontendIPConfigurationID, error) {
+	id, err := loadBalancerFrontendIPConfigurationIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerFrontendIPConfigurationID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerFrontendIPConfigurationID) String() string {
+	return loadBalancerFrontendIPConfigurationIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var loadBalancerInboundNatPoolIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Inbound NAT Pool",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "inboundNatPools"},
+}
+
+// LoadBalancerInboundNatPoolID is the ID of a Load Balancer Inbound NAT Pool.
+type LoadBalancerInboundNatPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolID, error) {
+	id, err := loadBalancerInboundNatPoolIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatPoolID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerInboundNatPoolID) String() string {
+	return loadBalancerInboundNatPoolIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var loadBalancerInboundNatRuleIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Inbound NAT Rule",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "inboundNatRules"},
+}
+
+// LoadBalancerInboundNatRuleID is the ID of a Load Balancer Inbound NAT Rule.
+type LoadBalancerInboundNatRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	id, err := loadBalancerInboundNatRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatRuleID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerInboundNatRuleID) String() string {
+	return loadBalancerInboundNatRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var loadBalancerProbeIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Probe",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "probes"},
+}
+
+// LoadBalancerProbeID is the ID of a Load Balancer Probe.
+type LoadBalancerProbeID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	id, err := loadBalancerProbeIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerProbeID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerProbeID) String() string {
+	return loadBalancerProbeIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var loadBalancerRuleIDFormat = resourceIDFormat{
+	resourceType: "Load Balancer Rule",
+	provider:     "Microsoft.Network",
+	keys:         []string{"loadBalancers", "loadBalancingRules"},
+}
+
+// LoadBalancerRuleID is the ID of a Load Balancer Rule.
+type LoadBalancerRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerRuleID(input string) (*LoadBalancerRuleID, error) {
+	id, err := loadBalancerRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerRuleID{
+		SubscriptionID:   id.SubscriptionID,
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: id.Segments[0].Value,
+		Name:             id.Segments[1].Value,
+	}, nil
+}
+
+func (id LoadBalancerRuleID) String() string {
+	return loadBalancerRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+var localNetworkGatewayIDFormat = resourceIDFormat{
+	resourceType: "Local Network Gateway",
+	provider:     "Microsoft.Network",
+	keys:         []string{"localNetworkGateways"},
+}
+
+// LocalNetworkGatewayID is the ID of a Local Network Gateway.
+type LocalNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	id, err := localNetworkGatewayIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalNetworkGatewayID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id LocalNetworkGatewayID) String() string {
+	return localNetworkGatewayIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var networkInterfaceIDFormat = resourceIDFormat{
+	resourceType: "Network Interface",
+	provider:     "Microsoft.Network",
+	keys:         []string{"networkInterfaces"},
+}
+
+// NetworkInterfaceID is the ID of a Network Interface.
+type NetworkInterfaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, err := networkInterfaceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id NetworkInterfaceID) String() string {
+	return networkInterfaceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var networkSecurityGroupIDFormat = resourceIDFormat{
+	resourceType: "Network Security Group",
+	provider:     "Microsoft.Network",
+	keys:         []string{"networkSecurityGroups"},
+}
+
+// NetworkSecurityGroupID is the ID of a Network Security Group.
+type NetworkSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, err := networkSecurityGroupIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id NetworkSecurityGroupID) String() string {
+	return networkSecurityGroupIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var networkSecurityRuleIDFormat = resourceIDFormat{
+	resourceType: "Network Security Rule",
+	provider:     "Microsoft.Network",
+	keys:         []string{"networkSecurityGroups", "securityRules"},
+}
+
+// NetworkSecurityRuleID is the ID of a Network Security Rule.
+type NetworkSecurityRuleID struct {
+	SubscriptionID           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+func parseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	id, err := networkSecurityRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityRuleID{
+		SubscriptionID:           id.SubscriptionID,
+		ResourceGroup:            id.ResourceGroup,
+		NetworkSecurityGroupName: id.Segments[0].Value,
+		Name:                     id.Segments[1].Value,
+	}, nil
+}
+
+func (id NetworkSecurityRuleID) String() string {
+	return networkSecurityRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+var publicIPAddressIDFormat = resourceIDFormat{
+	resourceType: "Public IP Address",
+	provider:     "Microsoft.Network",
+	keys:         []string{"publicIPAddresses"},
+}
+
+// PublicIPAddressID is the ID of a Public IP Address.
+type PublicIPAddressID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	id, err := publicIPAddressIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPAddressID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id PublicIPAddressID) String() string {
+	return publicIPAddressIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var routeIDFormat = resourceIDFormat{
+	resourceType: "Route",
+	provider:     "Microsoft.Network",
+	keys:         []string{"routeTables", "routes"},
+}
+
+// RouteID is the ID of a Route.
+type RouteID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+func parseRouteID(input string) (*RouteID, error) {
+	id, err := routeIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		RouteTableName: id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id RouteID) String() string {
+	return routeIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+var routeTableIDFormat = resourceIDFormat{
+	resourceType: "Route Table",
+	provider:     "Microsoft.Network",
+	keys:         []string{"routeTables"},
+}
+
+// RouteTableID is the ID of a Route Table.
+type RouteTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseRouteTableID(input string) (*RouteTableID, error) {
+	id, err := routeTableIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteTableID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id RouteTableID) String() string {
+	return routeTableIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var subnetIDFormat = resourceIDFormat{
+	resourceType: "Subnet",
+	provider:     "Microsoft.Network",
+	keys:         []string{"virtualNetworks", "subnets"},
+}
+
+// SubnetID is the ID of a Subnet.
+type SubnetID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func parseSubnetID(input string) (*SubnetID, error) {
+	id, err := subnetIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubnetID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		VirtualNetworkName: id.Segments[0].Value,
+		Name:               id.Segments[1].Value,
+	}, nil
+}
+
+func (id SubnetID) String() string {
+	return subnetIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+var virtualNetworkIDFormat = resourceIDFormat{
+	resourceType: "Virtual Network",
+	provider:     "Microsoft.Network",
+	keys:         []string{"virtualNetworks"},
+}
+
+// VirtualNetworkID is the ID of a Virtual Network.
+type VirtualNetworkID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	id, err := virtualNetworkIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id VirtualNetworkID) String() string {
+	return virtualNetworkIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var virtualNetworkPeeringIDFormat = resourceIDFormat{
+	resourceType: "Virtual Network Peering",
+	provider:     "Microsoft.Network",
+	keys:         []string{"virtualNetworks", "virtualNetworkPeerings"},
+}
+
+// VirtualNetworkPeeringID is the ID of a Virtual Network Peering.
+type VirtualNetworkPeeringID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func parseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	id, err := virtualNetworkPeeringIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkPeeringID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		VirtualNetworkName: id.Segments[0].Value,
+		Name:               id.Segments[1].Value,
+	}, nil
+}
+
+func (id VirtualNetworkPeeringID) String() string {
+	return virtualNetworkPeeringIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+var redisCacheIDFormat = resourceIDFormat{
+	resourceType: "Redis Cache",
+	provider:     "Microsoft.Cache",
+	keys:         []string{"Redis"},
+}
+
+// RedisCacheID is the ID of a Redis Cache.
+type RedisCacheID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseRedisCacheID(input string) (*RedisCacheID, error) {
+	id, err := redisCacheIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCacheID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id RedisCacheID) String() string {
+	return redisCacheIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
@@ -0,0 +1,57 @@
+package azurerm
+
+var templateDeploymentIDFormat = resourceIDFormat{
+	resourceType: "Template Deployment",
+	provider:     "Microsoft.Resources",
+	keys:         []string{"deployments"},
+}
+
+// TemplateDeploymentID is the ID of a Template Deployment.
+type TemplateDeploymentID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	id, err := templateDeploymentIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateDeploymentID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id TemplateDeploymentID) String() string {
+	return templateDeploymentIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var resourceGroupIDFormat = resourceIDFormat{
+	resourceType: "Resource Group",
+}
+
+// ResourceGroupID is the ID of a Resource Group.
+type ResourceGroupID struct {
+	SubscriptionID string
+	Name           string
+}
+
+func parseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := resourceGroupIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceGroupID{
+		SubscriptionID: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}, nil
+}
+
+func (id ResourceGroupID) String() string {
+	return resourceGroupIDFormat.format(id.SubscriptionID, id.Name)
+}
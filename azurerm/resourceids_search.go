@@ -0,0 +1,31 @@
+package azurerm
+
+var searchServiceIDFormat = resourceIDFormat{
+	resourceType: "Search Service",
+	provider:     "Microsoft.Search",
+	keys:         []string{"searchServices"},
+}
+
+// SearchServiceID is the ID of a Search Service.
+type SearchServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseSearchServiceID(input string) (*SearchServiceID, error) {
+	id, err := searchServiceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchServiceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id SearchServiceID) String() string {
+	return searchServiceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
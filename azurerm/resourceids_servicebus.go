@@ -0,0 +1,129 @@
+package azurerm
+
+var serviceBusNamespaceIDFormat = resourceIDFormat{
+	resourceType: "ServiceBus Namespace",
+	provider:     "Microsoft.ServiceBus",
+	keys:         []string{"namespaces"},
+}
+
+// ServiceBusNamespaceID is the ID of a ServiceBus Namespace.
+type ServiceBusNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	id, err := serviceBusNamespaceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id ServiceBusNamespaceID) String() string {
+	return serviceBusNamespaceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var serviceBusQueueIDFormat = resourceIDFormat{
+	resourceType: "ServiceBus Queue",
+	provider:     "Microsoft.ServiceBus",
+	keys:         []string{"namespaces", "queues"},
+}
+
+// ServiceBusQueueID is the ID of a ServiceBus Queue.
+type ServiceBusQueueID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+func parseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	id, err := serviceBusQueueIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id ServiceBusQueueID) String() string {
+	return serviceBusQueueIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+var serviceBusTopicIDFormat = resourceIDFormat{
+	resourceType: "ServiceBus Topic",
+	provider:     "Microsoft.ServiceBus",
+	keys:         []string{"namespaces", "topics"},
+}
+
+// ServiceBusTopicID is the ID of a ServiceBus Topic.
+type ServiceBusTopicID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+func parseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	id, err := serviceBusTopicIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id ServiceBusTopicID) String() string {
+	return serviceBusTopicIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+var serviceBusSubscriptionIDFormat = resourceIDFormat{
+	resourceType: "ServiceBus Subscription",
+	provider:     "Microsoft.ServiceBus",
+	keys:         []string{"namespaces", "topics", "subscriptions"},
+}
+
+// ServiceBusSubscriptionID is the ID of a ServiceBus Subscription.
+type ServiceBusSubscriptionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+func parseServiceBusSubscriptionID(input string) (*ServiceBusSubscriptionID, error) {
+	id, err := serviceBusSubscriptionIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusSubscriptionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		NamespaceName:  id.Segments[0].Value,
+		TopicName:      id.Segments[1].Value,
+		Name:           id.Segments[2].Value,
+	}, nil
+}
+
+func (id ServiceBusSubscriptionID) String() string {
+	return serviceBusSubscriptionIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
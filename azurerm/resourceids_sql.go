@@ -0,0 +1,127 @@
+package azurerm
+
+var sqlServerIDFormat = resourceIDFormat{
+	resourceType: "SQL Server",
+	provider:     "Microsoft.Sql",
+	keys:         []string{"servers"},
+}
+
+// SqlServerID is the ID of a SQL Server.
+type SqlServerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseSqlServerID(input string) (*SqlServerID, error) {
+	id, err := sqlServerIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlServerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id SqlServerID) String() string {
+	return sqlServerIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+var sqlElasticPoolIDFormat = resourceIDFormat{
+	resourceType: "SQL Elastic Pool",
+	provider:     "Microsoft.Sql",
+	keys:         []string{"servers", "elasticPools"},
+}
+
+// SqlElasticPoolID is the ID of a SQL Elastic Pool.
+type SqlElasticPoolID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+func parseSqlElasticPoolID(input string) (*SqlElasticPoolID, error) {
+	id, err := sqlElasticPoolIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlElasticPoolID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ServerName:     id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id SqlElasticPoolID) String() string {
+	return sqlElasticPoolIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+var sqlFirewallRuleIDFormat = resourceIDFormat{
+	resourceType: "SQL Firewall Rule",
+	provider:     "Microsoft.Sql",
+	keys:         []string{"servers", "firewallRules"},
+}
+
+// SqlFirewallRuleID is the ID of a SQL Firewall Rule.
+type SqlFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+func parseSqlFirewallRuleID(input string) (*SqlFirewallRuleID, error) {
+	id, err := sqlFirewallRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ServerName:     id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id SqlFirewallRuleID) String() string {
+	return sqlFirewallRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+var sqlDatabaseIDFormat = resourceIDFormat{
+	resourceType: "SQL Database",
+	provider:     "Microsoft.Sql",
+	keys:         []string{"servers", "databases"},
+}
+
+// SqlDatabaseID is the ID of a SQL Database.
+type SqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+func parseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	id, err := sqlDatabaseIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlDatabaseID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ServerName:     id.Segments[0].Value,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id SqlDatabaseID) String() string {
+	return sqlDatabaseIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
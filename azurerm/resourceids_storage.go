@@ -0,0 +1,221 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+)
+
+var storageAccountIDFormat = resourceIDFormat{
+	resourceType: "Storage Account",
+	provider:     "Microsoft.Storage",
+	keys:         []string{"storageAccounts"},
+}
+
+// StorageAccountID is the ID of a Storage Account.
+type StorageAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseStorageAccountID(input string) (*StorageAccountID, error) {
+	id, err := storageAccountIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id StorageAccountID) String() string {
+	return storageAccountIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// The objects within a Storage Account (Containers, Blobs, Queues, Shares and Tables) are managed through
+// the data plane rather than Azure Resource Manager - so their ID's follow the format ARM uses for them,
+// where each service within the Storage Account is named `default`.
+const storageServiceName = "default"
+
+var storageContainerIDFormat = resourceIDFormat{
+	resourceType: "Storage Container",
+	provider:     "Microsoft.Storage",
+	keys:         []string{"storageAccounts", "blobServices", "containers"},
+}
+
+// StorageContainerID is the ID of a Container within a Storage Account.
+type StorageContainerID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+func parseStorageContainerID(input string) (*StorageContainerID, error) {
+	id, err := storageContainerIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageContainerID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		StorageAccountName: id.Segments[0].Value,
+		Name:               id.Segments[2].Value,
+	}, nil
+}
+
+func (id StorageContainerID) String() string {
+	return storageContainerIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.StorageAccountName, storageServiceName, id.Name)
+}
+
+// StorageBlobID is the ID of a Blob within a Storage Container. Since the name of a Blob can contain
+// slashes, it's the remainder of the ID after the `blobs` segment.
+type StorageBlobID struct {
+	SubscriptionID       string
+	ResourceGroup        string
+	StorageAccountName   string
+	StorageContainerName string
+	Name                 string
+}
+
+// storageBlobIDSegments is the number of segments in a Storage Blob ID when split on slashes: the 13 segments
+// of the Storage Container ID (including the empty segment before the leading slash), followed by the `blobs`
+// segment and the name of the Blob - which isn't split further, since it can contain slashes.
+const storageBlobIDSegments = 15
+
+func parseStorageBlobID(input string) (*StorageBlobID, error) {
+	// the `blobs` segment is the one following the Storage Container, since the names of the Resource Group or
+	// Storage Container can also be `blobs`
+	segments := strings.SplitN(input, "/", storageBlobIDSegments)
+	if len(segments) != storageBlobIDSegments || !strings.EqualFold(segments[storageBlobIDSegments-2], "blobs") {
+		return nil, fmt.Errorf("Error parsing Storage Blob ID %q: expected a `blobs` segment following the Storage Container", input)
+	}
+
+	container, err := parseStorageContainerID(strings.Join(segments[:storageBlobIDSegments-2], "/"))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Blob ID %q: %+v", input, err)
+	}
+
+	name := segments[storageBlobIDSegments-1]
+	if name == "" {
+		return nil, fmt.Errorf("Error parsing Storage Blob ID %q: the name of the Blob is empty", input)
+	}
+
+	return &StorageBlobID{
+		SubscriptionID:       container.SubscriptionID,
+		ResourceGroup:        container.ResourceGroup,
+		StorageAccountName:   container.StorageAccountName,
+		StorageContainerName: container.Name,
+		Name:                 name,
+	}, nil
+}
+
+func (id StorageBlobID) String() string {
+	container := StorageContainerID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		StorageAccountName: id.StorageAccountName,
+		Name:               id.StorageContainerName,
+	}
+	return fmt.Sprintf("%s/blobs/%s", container.String(), id.Name)
+}
+
+var storageQueueIDFormat = resourceIDFormat{
+	resourceType: "Storage Queue",
+	provider:     "Microsoft.Storage",
+	keys:         []string{"storageAccounts", "queueServices", "queues"},
+}
+
+// StorageQueueID is the ID of a Queue within a Storage Account.
+type StorageQueueID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+func parseStorageQueueID(input string) (*StorageQueueID, error) {
+	id, err := storageQueueIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageQueueID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		StorageAccountName: id.Segments[0].Value,
+		Name:               id.Segments[2].Value,
+	}, nil
+}
+
+func (id StorageQueueID) String() string {
+	return storageQueueIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.StorageAccountName, storageServiceName, id.Name)
+}
+
+var storageShareIDFormat = resourceIDFormat{
+	resourceType: "Storage Share",
+	provider:     "Microsoft.Storage",
+	keys:         []string{"storageAccounts", "fileServices", "shares"},
+}
+
+// StorageShareID is the ID of a File Share within a Storage Account.
+type StorageShareID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+func parseStorageShareID(input string) (*StorageShareID, error) {
+	id, err := storageShareIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageShareID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		StorageAccountName: id.Segments[0].Value,
+		Name:               id.Segments[2].Value,
+	}, nil
+}
+
+func (id StorageShareID) String() string {
+	return storageShareIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.StorageAccountName, storageServiceName, id.Name)
+}
+
+var storageTableIDFormat = resourceIDFormat{
+	resourceType: "Storage Table",
+	provider:     "Microsoft.Storage",
+	keys:         []string{"storageAccounts", "tableServices", "tables"},
+}
+
+// StorageTableID is the ID of a Table within a Storage Account.
+type StorageTableID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+func parseStorageTableID(input string) (*StorageTableID, error) {
+	id, err := storageTableIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageTableID{
+		SubscriptionID:     id.SubscriptionID,
+		ResourceGroup:      id.ResourceGroup,
+		StorageAccountName: id.Segments[0].Value,
+		Name:               id.Segments[2].Value,
+	}, nil
+}
+
+func (id StorageTableID) String() string {
+	return storageTableIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.StorageAccountName, storageServiceName, id.Name)
+}
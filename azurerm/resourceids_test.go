@@ -0,0 +1,232 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceIDFormat_Parse(t *testing.T) {
+	format := resourceIDFormat{
+		resourceType: "Subnet",
+		provider:     "Microsoft.Network",
+		keys:         []string{"virtualNetworks", "subnets"},
+	}
+
+	testCases := []struct {
+		id          string
+		expectError bool
+	}{
+		{
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			false,
+		},
+		{
+			// segment names and the provider are case-insensitive
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/VirtualNetworks/network1/Subnets/subnet1",
+			false,
+		},
+		{
+			// segments in the wrong order
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/subnets/subnet1/virtualNetworks/network1",
+			true,
+		},
+		{
+			// a different provider
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualNetworks/network1/subnets/subnet1",
+			true,
+		},
+		{
+			// missing a segment
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			true,
+		},
+		{
+			// an additional segment
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/ipConfigurations/config1",
+			true,
+		},
+		{
+			// the provider isn't directly after the resource group
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/virtualNetworks/network1/providers/Microsoft.Network/subnets/subnet1",
+			true,
+		},
+		{
+			// the resource group isn't directly after the subscription
+			"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/resourceGroups/group1/virtualNetworks/network1/subnets/subnet1",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		id, err := format.parse(tc.id)
+		if tc.expectError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %+v", tc.id, err)
+		}
+
+		if id.Segments[0].Value != "network1" || id.Segments[1].Value != "subnet1" {
+			t.Fatalf("Unexpected segments parsed from %q: %+v", tc.id, id.Segments)
+		}
+	}
+}
+
+func TestParseSubnetID(t *testing.T) {
+	id, err := parseSubnetID("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/VirtualNetworks/network1/subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	expected := &SubnetID{
+		SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:      "group1",
+		VirtualNetworkName: "network1",
+		Name:               "subnet1",
+	}
+	if !reflect.DeepEqual(expected, id) {
+		t.Fatalf("Unexpected ID:\nExpected: %+v\nGot:      %+v", expected, id)
+	}
+
+	// the ID is normalized to the casing used by ARM
+	expectedID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	if actual := id.String(); actual != expectedID {
+		t.Fatalf("Expected the ID to be %q but got %q", expectedID, actual)
+	}
+}
+
+func TestParseServiceBusSubscriptionID(t *testing.T) {
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1"
+	id, err := parseServiceBusSubscriptionID(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	expected := &ServiceBusSubscriptionID{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "group1",
+		NamespaceName:  "namespace1",
+		TopicName:      "topic1",
+		Name:           "subscription1",
+	}
+	if !reflect.DeepEqual(expected, id) {
+		t.Fatalf("Unexpected ID:\nExpected: %+v\nGot:      %+v", expected, id)
+	}
+
+	if actual := id.String(); actual != input {
+		t.Fatalf("Expected the ID to be %q but got %q", input, actual)
+	}
+
+	if _, err := parseServiceBusTopicID(input); err == nil {
+		t.Fatalf("Expected an error parsing a Subscription ID as a Topic ID")
+	}
+}
+
+func TestParseDnsRecordID(t *testing.T) {
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/example.com/CNAME/www"
+	id, err := parseDnsRecordID(input, "CNAME")
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	if id.ZoneName != "example.com" || id.Name != "www" {
+		t.Fatalf("Unexpected ID: %+v", id)
+	}
+	if actual := id.String(); actual != input {
+		t.Fatalf("Expected the ID to be %q but got %q", input, actual)
+	}
+
+	if _, err := parseDnsRecordID(input, "A"); err == nil {
+		t.Fatalf("Expected an error parsing a CNAME Record ID as an A Record ID")
+	}
+}
+
+func TestParseTrafficManagerEndpointID(t *testing.T) {
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/trafficManagerProfiles/profile1/ExternalEndpoints/endpoint1"
+	id, err := parseTrafficManagerEndpointID(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	expected := &TrafficManagerEndpointID{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "group1",
+		ProfileName:    "profile1",
+		Type:           "externalEndpoints",
+		Name:           "endpoint1",
+	}
+	if !reflect.DeepEqual(expected, id) {
+		t.Fatalf("Unexpected ID:\nExpected: %+v\nGot:      %+v", expected, id)
+	}
+
+	if _, err := parseTrafficManagerEndpointID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/trafficManagerProfiles/profile1/otherEndpoints/endpoint1"); err == nil {
+		t.Fatalf("Expected an error parsing an unknown Endpoint Type")
+	}
+}
+
+func TestParseStorageBlobID(t *testing.T) {
+	// the name of a Blob can contain slashes
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds/blobs/images/disk1.vhd"
+	id, err := parseStorageBlobID(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	if id.StorageAccountName != "account1" || id.StorageContainerName != "vhds" || id.Name != "images/disk1.vhd" {
+		t.Fatalf("Unexpected ID: %+v", id)
+	}
+	if actual := id.String(); actual != input {
+		t.Fatalf("Expected the ID to be %q but got %q", input, actual)
+	}
+
+	// the Resource Group and Storage Container can also be named `blobs`
+	named := map[string]StorageBlobID{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/blobs/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds/blobs/disk1.vhd": {
+			ResourceGroup:        "blobs",
+			StorageContainerName: "vhds",
+			Name:                 "disk1.vhd",
+		},
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/blobs/blobs/disk1.vhd": {
+			ResourceGroup:        "group1",
+			StorageContainerName: "blobs",
+			Name:                 "disk1.vhd",
+		},
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds/blobs/blobs/disk1.vhd": {
+			ResourceGroup:        "group1",
+			StorageContainerName: "vhds",
+			Name:                 "blobs/disk1.vhd",
+		},
+	}
+	for input, expected := range named {
+		id, err := parseStorageBlobID(input)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %+v", input, err)
+		}
+
+		if id.ResourceGroup != expected.ResourceGroup || id.StorageContainerName != expected.StorageContainerName || id.Name != expected.Name {
+			t.Fatalf("Unexpected ID parsing %q: %+v", input, id)
+		}
+		if actual := id.String(); actual != input {
+			t.Fatalf("Expected the ID to be %q but got %q", input, actual)
+		}
+	}
+
+	invalid := []string{
+		// a Storage Container
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds",
+		// a Storage Container within a Resource Group named `blobs`
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/blobs/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds",
+		// the name of the Blob is missing
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/vhds/blobs/",
+		// the ID used prior to the Blob being addressable by its ID
+		"disk1.vhd",
+	}
+	for _, v := range invalid {
+		if _, err := parseStorageBlobID(v); err == nil {
+			t.Fatalf("Expected an error parsing %q", v)
+		}
+	}
+}
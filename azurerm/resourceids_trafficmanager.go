@@ -0,0 +1,99 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+)
+
+var trafficManagerProfileIDFormat = resourceIDFormat{
+	resourceType: "Traffic Manager Profile",
+	provider:     "Microsoft.Network",
+	keys:         []string{"trafficManagerProfiles"},
+}
+
+// TrafficManagerProfileID is the ID of a Traffic Manager Profile.
+type TrafficManagerProfileID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseTrafficManagerProfileID(input string) (*TrafficManagerProfileID, error) {
+	id, err := trafficManagerProfileIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrafficManagerProfileID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.Segments[0].Value,
+	}, nil
+}
+
+func (id TrafficManagerProfileID) String() string {
+	return trafficManagerProfileIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// trafficManagerEndpointTypes are the types of Traffic Manager Endpoint, which are
+// used as the key of the segment containing the name of the Endpoint.
+var trafficManagerEndpointTypes = []string{
+	"azureEndpoints",
+	"externalEndpoints",
+	"nestedEndpoints",
+}
+
+func trafficManagerEndpointIDFormat(endpointType string) resourceIDFormat {
+	return resourceIDFormat{
+		resourceType: "Traffic Manager Endpoint",
+		provider:     "Microsoft.Network",
+		keys:         []string{"trafficManagerProfiles", endpointType},
+	}
+}
+
+// TrafficManagerEndpointID is the ID of a Traffic Manager Endpoint.
+type TrafficManagerEndpointID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ProfileName    string
+	Type           string
+	Name           string
+}
+
+func parseTrafficManagerEndpointID(input string) (*TrafficManagerEndpointID, error) {
+	components, err := splitAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: %+v", input, err)
+	}
+
+	// the type of the endpoint is the key of the last segment
+	endpointType := ""
+	if len(components.Segments) > 0 {
+		lastKey := components.Segments[len(components.Segments)-1].Key
+		for _, t := range trafficManagerEndpointTypes {
+			if strings.EqualFold(lastKey, t) {
+				endpointType = t
+			}
+		}
+	}
+	if endpointType == "" {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: expected the Endpoint Type to be one of %s", input, strings.Join(trafficManagerEndpointTypes, ", "))
+	}
+
+	id, err := trafficManagerEndpointIDFormat(endpointType).parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrafficManagerEndpointID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		ProfileName:    id.Segments[0].Value,
+		Type:           endpointType,
+		Name:           id.Segments[1].Value,
+	}, nil
+}
+
+func (id TrafficManagerEndpointID) String() string {
+	return trafficManagerEndpointIDFormat(id.Type).format(id.SubscriptionID, id.ResourceGroup, id.ProfileName, id.Name)
+}
@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// defaultMaxRetries is the number of times a throttled or failed request is retried
+// when `max_retries` isn't specified in the Provider block
+const defaultMaxRetries = 3
+
+var (
+	// retryBaseDelay is the delay before the first retry, which is doubled for each subsequent retry
+	retryBaseDelay = 2 * time.Second
+
+	// retryMaxDelay is the maximum delay between retries when no Retry-After header is returned
+	retryMaxDelay = 60 * time.Second
+
+	// retryMaxRetryAfter caps the delay requested by the Retry-After header
+	retryMaxRetryAfter = 10 * time.Minute
+)
+
+// retryableStatusCodes are the status codes returned by ARM when a request has been
+// throttled or has failed due to a transient error
+var retryableStatusCodes = map[int]struct{}{
+	http.StatusTooManyRequests:     {},
+	http.StatusInternalServerError: {},
+	http.StatusBadGateway:          {},
+	http.StatusServiceUnavailable:  {},
+	http.StatusGatewayTimeout:      {},
+}
+
+// withRetries returns a SendDecorator which retries requests which have been throttled
+// (429) or have failed with a transient error (a 5xx or the connection being reset) up to
+// `maxRetries` times. The delay requested by the Retry-After header is honoured - otherwise
+// an exponential backoff with jitter is used between each attempt.
+func withRetries(maxRetries int) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if attempt >= maxRetries || !shouldRetryRequest(resp, err) {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt)
+				if err != nil {
+					log.Printf("[DEBUG] AzureRM Request to %s failed with %q - retrying in %s (retry %d of %d)", r.URL, err, delay, attempt+1, maxRetries)
+				} else {
+					log.Printf("[DEBUG] AzureRM Request to %s returned %s - retrying in %s (retry %d of %d)", r.URL, resp.Status, delay, attempt+1, maxRetries)
+				}
+
+				if resp != nil && resp.Body != nil {
+					// drain the body so that the connection can be reused
+					io.Copy(ioutil.Discard, resp.Body)
+					resp.Body.Close()
+				}
+
+				if !waitForRetry(r, delay) {
+					return resp, err
+				}
+			}
+		})
+	}
+}
+
+func shouldRetryRequest(resp *http.Response, err error) bool {
+	if err != nil {
+		return isConnectionResetError(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	if _, ok := retryableStatusCodes[resp.StatusCode]; ok {
+		return true
+	}
+
+	// some errors (such as another operation being in progress) can be retried regardless of the Status Code
+	if armErr := parseArmError(resp); armErr != nil {
+		return armErr.retryable()
+	}
+
+	return false
+}
+
+// isConnectionResetError returns whether the error was caused by the connection being
+// reset or closed by the remote host, in which case the request can be retried.
+func isConnectionResetError(err error) bool {
+	for err != nil {
+		switch e := err.(type) {
+		case *url.Error:
+			err = e.Err
+			continue
+		case *net.OpError:
+			err = e.Err
+			continue
+		case *os.SyscallError:
+			err = e.Err
+			continue
+		}
+		break
+	}
+
+	if err == nil {
+		return false
+	}
+
+	if err == syscall.ECONNRESET || err == syscall.EPIPE || err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "connection reset by peer")
+}
+
+// retryDelay returns how long to wait before the next attempt - using the value of the
+// Retry-After header when present, falling back to an exponential backoff with jitter.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > retryMaxRetryAfter {
+				delay = retryMaxRetryAfter
+			}
+			return delay
+		}
+	}
+
+	backoff := float64(retryBaseDelay) * math.Pow(2, float64(attempt))
+	if backoff > float64(retryMaxDelay) {
+		backoff = float64(retryMaxDelay)
+	}
+
+	// use a random delay between half and the full backoff, to avoid parallel requests retrying in lock-step
+	half := backoff / 2
+	return time.Duration(half + rand.Float64()*half)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can either be a
+// number of seconds or a HTTP Date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// waitForRetry waits for the specified delay, returning false if the request is cancelled first.
+func waitForRetry(r *http.Request, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Cancel:
+		return false
+	case <-r.Context().Done():
+		return false
+	}
+}
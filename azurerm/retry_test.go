@@ -0,0 +1,293 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func withShortRetryDelays() func() {
+	baseDelay, maxDelay := retryBaseDelay, retryMaxDelay
+	retryBaseDelay = time.Millisecond
+	retryMaxDelay = 10 * time.Millisecond
+
+	return func() {
+		retryBaseDelay, retryMaxDelay = baseDelay, maxDelay
+	}
+}
+
+func testRetrySender(maxRetries int) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{}, withRetries(maxRetries))
+}
+
+func TestWithRetries_TransientErrors(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(&requests, 1)
+
+		// the request body must be sent on every attempt
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"location":"westus"}` {
+			t.Fatalf("Expected the request body to be replayed but got %q", string(body))
+		}
+
+		switch count {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, bytes.NewReader([]byte(`{"location":"westus"}`)))
+	resp, err := testRetrySender(3).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if count := atomic.LoadInt32(&requests); count != 3 {
+		t.Fatalf("Expected 3 requests but got %d", count)
+	}
+}
+
+func TestWithRetries_Throttling(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error":{"code":"TooManyRequests","message":"The request is being throttled."}}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testRetrySender(3).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if count := atomic.LoadInt32(&requests); count != 2 {
+		t.Fatalf("Expected 2 requests but got %d", count)
+	}
+}
+
+func TestWithRetries_RetryableErrorCode(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"error":{"code":"AnotherOperationInProgress","message":"Another operation on this resource is in progress."}}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, nil)
+	resp, err := testRetrySender(3).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if count := atomic.LoadInt32(&requests); count != 2 {
+		t.Fatalf("Expected 2 requests but got %d", count)
+	}
+}
+
+func TestWithRetries_MaxRetries(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testRetrySender(2).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected the final response to be returned but got %d", resp.StatusCode)
+	}
+	if count := atomic.LoadInt32(&requests); count != 3 {
+		t.Fatalf("Expected 3 requests (the initial request and 2 retries) but got %d", count)
+	}
+}
+
+func TestWithRetries_NonRetryableStatusCodes(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	for _, statusCode := range []int{http.StatusOK, http.StatusAccepted, http.StatusBadRequest, http.StatusNotFound, http.StatusConflict} {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(statusCode)
+		}))
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, err := testRetrySender(3).Do(req); err != nil {
+			t.Fatalf("Expected no error for %d but got: %+v", statusCode, err)
+		}
+		if count := atomic.LoadInt32(&requests); count != 1 {
+			t.Fatalf("Expected a %d not to be retried, but %d requests were made", statusCode, count)
+		}
+
+		server.Close()
+	}
+}
+
+func TestWithRetries_ConnectionReset(t *testing.T) {
+	defer withShortRetryDelays()()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			// drop the connection without returning a response
+			hijacker, ok := w.(http.Hijacker)
+			if !ok {
+				t.Fatalf("Expected the ResponseWriter to support hijacking")
+			}
+			conn, _, err := hijacker.Hijack()
+			if err != nil {
+				t.Fatalf("Error hijacking the connection: %+v", err)
+			}
+			conn.Close()
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testRetrySender(3).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if count := atomic.LoadInt32(&requests); count != 2 {
+		t.Fatalf("Expected 2 requests but got %d", count)
+	}
+}
+
+func TestWithRetries_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cancel := make(chan struct{})
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Cancel = cancel
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(cancel)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		testRetrySender(3).Do(req)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Expected the retries to stop once the request was cancelled")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"abc", 0, false},
+		{"-1", 0, false},
+		{"0", 0, true},
+		{"17", 17 * time.Second, true},
+		{time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tc := range testCases {
+		delay, ok := parseRetryAfter(tc.value)
+		if ok != tc.ok {
+			t.Fatalf("Expected parsing %q to return %t but got %t", tc.value, tc.ok, ok)
+		}
+		if delay != tc.expected {
+			t.Fatalf("Expected the delay for %q to be %s but got %s", tc.value, tc.expected, delay)
+		}
+	}
+
+	delay, ok := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if !ok || delay < 59*time.Minute || delay > time.Hour {
+		t.Fatalf("Expected a HTTP Date an hour from now to be parsed as ~1h but got %s", delay)
+	}
+}
+
+func TestRetryDelay_ExponentialBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := retryDelay(nil, attempt)
+
+		expected := retryBaseDelay * time.Duration(1<<uint(attempt))
+		if expected > retryMaxDelay {
+			expected = retryMaxDelay
+		}
+
+		if delay < expected/2 || delay > expected {
+			t.Fatalf("Expected the delay for attempt %d to be between %s and %s but got %s", attempt, expected/2, expected, delay)
+		}
+	}
+}
+
+func TestRetryDelay_RetryAfter(t *testing.T) {
+	resp := &http.Response{
+		Header: http.Header{},
+	}
+
+	resp.Header.Set("Retry-After", "30")
+	if delay := retryDelay(resp, 5); delay != 30*time.Second {
+		t.Fatalf("Expected the Retry-After header to be honoured but got %s", delay)
+	}
+
+	resp.Header.Set("Retry-After", "86400")
+	if delay := retryDelay(resp, 0); delay != retryMaxRetryAfter {
+		t.Fatalf("Expected the Retry-After header to be capped at %s but got %s", retryMaxRetryAfter, delay)
+	}
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Resources which can be used across Subscriptions (such as those making up a hub-and-spoke network) opt
+// into an optional `subscription_id`, which provisions them in a different Subscription to the one
+// configured in the Provider block - using the same credentials - without aliasing the Provider.
+const subscriptionIDKey = "subscription_id"
+
+func subscriptionIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
+// withSubscriptionOverride adds the `subscription_id` field to the resource, and wraps its functions
+// such that they're called with an ArmClient for that Subscription.
+func withSubscriptionOverride(r *schema.Resource) *schema.Resource {
+	r.Schema[subscriptionIDKey] = subscriptionIDSchema()
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, subscriptionClient(d, meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			client := subscriptionClient(d, meta)
+			if err := read(d, client); err != nil {
+				return err
+			}
+
+			if d.Id() != "" {
+				d.Set(subscriptionIDKey, client.subscriptionId)
+			}
+			return nil
+		}
+	}
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return exists(d, subscriptionClient(d, meta))
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, subscriptionClient(d, meta))
+		}
+	}
+
+	return r
+}
+
+// subscriptionClient returns the ArmClient for the Subscription the resource is in: which is the one
+// within its ID once it exists, otherwise the `subscription_id` specified in the configuration.
+func subscriptionClient(d *schema.ResourceData, meta interface{}) *ArmClient {
+	client := meta.(*ArmClient)
+
+	if d.Id() != "" {
+		if id, err := parseAzureResourceID(d.Id()); err == nil && id.SubscriptionID != "" {
+			return client.forSubscription(id.SubscriptionID)
+		}
+	}
+
+	return client.forSubscription(d.Get(subscriptionIDKey).(string))
+}
+
+// forSubscription returns an ArmClient for the specified Subscription, which shares the credentials
+// (and settings) of this one. The clients for each Subscription are cached, so that the SDK clients
+// are only built once.
+func (c *ArmClient) forSubscription(subscriptionId string) *ArmClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) {
+		return c
+	}
+
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	client, ok := c.subscriptionClients[key]
+	if !ok {
+		client = &ArmClient{
+			clientId:             c.clientId,
+			tenantId:             c.tenantId,
+			subscriptionId:       subscriptionId,
+			environment:          c.environment,
+			supportedAPIVersions: c.supportedAPIVersions,
+			maxRetries:           c.maxRetries,
+			sender:               c.sender,
+			defaultTags:          c.defaultTags,
+			StopContext:          c.StopContext,
+		}
+
+		// namespaces are registered per-Subscription
+		if c.providerRegistrations != nil {
+			client.providerRegistrations = newResourceProviderRegistrations(client.providers, nil)
+		}
+
+		options := *c.clients.options
+		options.subscriptionId = subscriptionId
+		options.configureClient = client.configureClient
+		client.clients = newClientRegistry(&options)
+
+		if c.subscriptionClients == nil {
+			c.subscriptionClients = make(map[string]*ArmClient)
+		}
+		c.subscriptionClients[key] = client
+	}
+
+	return client
+}
+
+// setStopContext replaces the context of this ArmClient and those for other Subscriptions, which happens
+// between runs - rather than whilst resources are being provisioned.
+func (c *ArmClient) setStopContext(ctx context.Context) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+
+	c.StopContext = ctx
+	for _, client := range c.subscriptionClients {
+		client.StopContext = ctx
+	}
+}
@@ -0,0 +1,25 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestProvider_resourcesSupportSubscriptionOverride(t *testing.T) {
+	supported := []string{
+		"azurerm_dns_zone",
+		"azurerm_resource_group",
+		"azurerm_virtual_network",
+		"azurerm_virtual_network_peering",
+	}
+
+	provider := Provider().(*schema.Provider)
+
+	for name, resource := range provider.ResourcesMap {
+		_, exists := resource.Schema[subscriptionIDKey]
+		if expected := sliceContainsValue(supported, name); exists != expected {
+			t.Fatalf("Expected the resource %q to support `subscription_id` to be %t but got %t", name, expected, exists)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -16,6 +17,20 @@ func tagsSchema() *schema.Schema {
 	}
 }
 
+// outdatedTagsSchema is added to each taggable resource so that changes to the Provider's `default_tags`
+// show up in the plan: Terraform 0.9 doesn't allow a Provider to alter the diff, so instead the Tags applied
+// in Azure are stored in this field when they're out of date - which the next plan then removes.
+func outdatedTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+			es = append(es, fmt.Errorf("%q is set by the Provider and can't be specified", k))
+			return
+		},
+	}
+}
+
 func tagsForDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -83,3 +98,102 @@ func flattenAndSetTags(d *schema.ResourceData, tagsMap *map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// mergeDefaultTags returns the Tags specified for the resource merged with the Default Tags
+// specified in the Provider block, where the Tags specified for the resource take precedence.
+// Since the merged Tags are sent to Azure, these are validated against the same limits.
+func mergeDefaultTags(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	tags := d.Get("tags").(map[string]interface{})
+	defaultTags := meta.(*ArmClient).defaultTags
+	if len(defaultTags) == 0 {
+		return tags, nil
+	}
+
+	output := make(map[string]interface{}, len(tags)+len(defaultTags))
+	for k, v := range defaultTags {
+		// Tag names are case-insensitive, so a resource can override a Default Tag using any casing
+		if _, exists := findTag(tags, k); !exists {
+			output[k] = v
+		}
+	}
+	for k, v := range tags {
+		output[k] = v
+	}
+
+	if _, errors := validateAzureRMTags(output, "tags"); len(errors) > 0 {
+		return nil, fmt.Errorf("Error validating the Tags merged with the Provider's `default_tags`: %+v", errors)
+	}
+
+	return output, nil
+}
+
+// removeDefaultTags removes any of the Default Tags specified in the Provider block from the
+// Tags returned from Azure, unless they're specified for the resource - such that the Default
+// Tags don't show up as a diff for each resource. Where the Default Tags applied in Azure are
+// out of date the Tags returned from Azure are set as the `outdated_tags` instead, which
+// surfaces the change in the plan.
+func removeDefaultTags(d *schema.ResourceData, meta interface{}, tagsMap *map[string]*string) *map[string]*string {
+	defaultTags := meta.(*ArmClient).defaultTags
+	tags := d.Get("tags").(map[string]interface{})
+
+	d.Set("outdated_tags", findOutdatedTags(tags, defaultTags, tagsMap))
+
+	if tagsMap == nil || len(defaultTags) == 0 {
+		return tagsMap
+	}
+
+	output := make(map[string]*string, len(*tagsMap))
+	for k, v := range *tagsMap {
+		if _, specified := findTag(tags, k); !specified {
+			// when the Default Tag has been changed this is surfaced through the `outdated_tags`
+			if _, isDefault := findTag(defaultTags, k); isDefault {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return &output
+}
+
+// findOutdatedTags returns the Tags applied in Azure if any of the Default Tags (which aren't overridden
+// for the resource) are missing or have a different value - otherwise an empty map is returned.
+func findOutdatedTags(tags map[string]interface{}, defaultTags map[string]interface{}, tagsMap *map[string]*string) map[string]interface{} {
+	applied := make(map[string]interface{})
+	if tagsMap != nil {
+		for k, v := range *tagsMap {
+			if v != nil {
+				applied[k] = *v
+			}
+		}
+	}
+
+	for k, v := range defaultTags {
+		if _, overridden := findTag(tags, k); overridden {
+			continue
+		}
+
+		defaultValue, _ := tagValueToString(v)
+		if value, exists := findTag(applied, k); !exists || value != defaultValue {
+			return applied
+		}
+	}
+
+	return make(map[string]interface{})
+}
+
+// findTag returns the value of the Tag with the specified name, which is case-insensitive
+func findTag(tags map[string]interface{}, name string) (interface{}, bool) {
+	if v, ok := tags[name]; ok {
+		return v, true
+	}
+
+	for k, v := range tags {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
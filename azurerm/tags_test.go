@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -95,3 +97,159 @@ func TestExpandARMTags(t *testing.T) {
 		}
 	}
 }
+
+func testTagsResourceData(t *testing.T, tags map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags":          tagsSchema(),
+		"outdated_tags": outdatedTagsSchema(),
+	}, map[string]interface{}{
+		"tags": tags,
+	})
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"cost-centre": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	d := testTagsResourceData(t, map[string]interface{}{
+		"Owner":       "web",
+		"environment": "production",
+	})
+
+	tags, err := mergeDefaultTags(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"cost-centre": "1234",
+		"Owner":       "web",
+		"environment": "production",
+	}
+	if len(tags) != len(expected) {
+		t.Fatalf("Expected %d tags but got %d: %+v", len(expected), len(tags), tags)
+	}
+	for k, v := range expected {
+		if tags[k] != v {
+			t.Fatalf("Expected the tag %q to be %q but got %q", k, v, tags[k])
+		}
+	}
+}
+
+func TestMergeDefaultTags_TooManyTags(t *testing.T) {
+	defaultTags := make(map[string]interface{})
+	resourceTags := make(map[string]interface{})
+	for i := 0; i < 8; i++ {
+		defaultTags[fmt.Sprintf("default%d", i)] = "value"
+		resourceTags[fmt.Sprintf("resource%d", i)] = "value"
+	}
+
+	meta := &ArmClient{
+		defaultTags: defaultTags,
+	}
+	d := testTagsResourceData(t, resourceTags)
+
+	if _, err := mergeDefaultTags(d, meta); err == nil {
+		t.Fatalf("Expected an error when the merged tags exceed the maximum number of tags")
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"cost-centre": "1234",
+			"owner":       "platform",
+			"team":        "networking",
+		},
+	}
+
+	d := testTagsResourceData(t, map[string]interface{}{
+		"owner": "platform",
+	})
+
+	costCentre, owner, team, environment := "1234", "platform", "compute", "production"
+	tags := removeDefaultTags(d, meta, &map[string]*string{
+		"Cost-Centre": &costCentre,
+		"owner":       &owner,
+		"team":        &team,
+		"environment": &environment,
+	})
+
+	// the default `cost-centre` and `team` tags are removed and `owner` is also specified for the resource
+	expected := map[string]string{
+		"owner":       "platform",
+		"environment": "production",
+	}
+	if len(*tags) != len(expected) {
+		t.Fatalf("Expected %d tags but got %d: %+v", len(expected), len(*tags), *tags)
+	}
+	for k, v := range expected {
+		if value, ok := (*tags)[k]; !ok || *value != v {
+			t.Fatalf("Expected the tag %q to be %q", k, v)
+		}
+	}
+
+	// whereas the value of `team` doesn't match the default, so it needs to be updated
+	if outdated := d.Get("outdated_tags").(map[string]interface{}); len(outdated) != 4 {
+		t.Fatalf("Expected the applied tags to be outdated but got %+v", outdated)
+	}
+}
+
+func TestFindOutdatedTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "platform",
+	}
+
+	testCases := []struct {
+		name     string
+		tags     map[string]interface{}
+		applied  map[string]string
+		outdated bool
+	}{
+		{
+			name:     "up to date",
+			tags:     map[string]interface{}{},
+			applied:  map[string]string{"Cost-Centre": "1234", "owner": "platform"},
+			outdated: false,
+		},
+		{
+			name:     "overridden for the resource",
+			tags:     map[string]interface{}{"owner": "web"},
+			applied:  map[string]string{"cost-centre": "1234", "owner": "web"},
+			outdated: false,
+		},
+		{
+			name:     "changed",
+			tags:     map[string]interface{}{},
+			applied:  map[string]string{"cost-centre": "1234", "owner": "web"},
+			outdated: true,
+		},
+		{
+			name:     "added",
+			tags:     map[string]interface{}{},
+			applied:  map[string]string{"owner": "platform"},
+			outdated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		applied := make(map[string]*string)
+		for k, v := range tc.applied {
+			value := v
+			applied[k] = &value
+		}
+
+		outdated := findOutdatedTags(tc.tags, defaultTags, &applied)
+		if tc.outdated && len(outdated) != len(tc.applied) {
+			t.Fatalf("Expected the applied Tags to be outdated for %q but got %+v", tc.name, outdated)
+		}
+		if !tc.outdated && len(outdated) != 0 {
+			t.Fatalf("Expected no outdated Tags for %q but got %+v", tc.name, outdated)
+		}
+	}
+}
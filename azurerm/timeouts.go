@@ -0,0 +1,29 @@
+package azurerm
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// createOrUpdateTimeout returns the Create Timeout for new resources, otherwise the Update
+// Timeout - since many resources use the same function to both Create and Update.
+func createOrUpdateTimeout(d *schema.ResourceData) time.Duration {
+	if d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
+// remainingTimeout returns the time left until the context's deadline (or `timeout` when it has none), so
+// that waiting for a resource after a long-running operation completes doesn't extend the resource's Timeout.
+func remainingTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeout
+	}
+
+	return deadline.Sub(time.Now())
+}
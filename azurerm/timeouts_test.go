@@ -0,0 +1,27 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestProvider_resourcesSupportTimeouts(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	// every resource supports a `timeouts` block - including those which only make synchronous API calls,
+	// where it isn't enforced (as documented on each resource) since the requests can't be cancelled
+	for name, resource := range provider.ResourcesMap {
+		if resource.Timeouts == nil {
+			t.Fatalf("Expected the resource %q to support a `timeouts` block", name)
+		}
+
+		timeouts := resource.Timeouts
+		if timeouts.Create == nil || timeouts.Delete == nil {
+			t.Fatalf("Expected the resource %q to support Create and Delete timeouts", name)
+		}
+		if (resource.Update != nil) != (timeouts.Update != nil) {
+			t.Fatalf("Expected the resource %q to only support an Update timeout when it can be updated", name)
+		}
+	}
+}